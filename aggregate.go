@@ -15,12 +15,18 @@ func Aggregate[Source any](source Enumerator[Source], accumulator func(Source, S
 		return ZeroValue[Source](), ErrNilAccumulator
 	}
 	if !source.MoveNext() {
+		if err := Err(source); err != nil {
+			return ZeroValue[Source](), err
+		}
 		return ZeroValue[Source](), ErrEmptySource
 	}
 	r := source.Current()
 	for source.MoveNext() {
 		r = accumulator(r, source.Current())
 	}
+	if err := Err(source); err != nil {
+		return ZeroValue[Source](), err
+	}
 	return r, nil
 }
 
@@ -47,6 +53,9 @@ func AggregateSeed[Source, Accumulate any](source Enumerator[Source],
 	for source.MoveNext() {
 		r = accumulator(r, source.Current())
 	}
+	if err := Err(source); err != nil {
+		return ZeroValue[Accumulate](), err
+	}
 	return r, nil
 }
 
@@ -78,6 +87,9 @@ func AggregateSeedSel[Source, Accumulate, Result any](source Enumerator[Source],
 	for source.MoveNext() {
 		r = accumulator(r, source.Current())
 	}
+	if err := Err(source); err != nil {
+		return ZeroValue[Result](), err
+	}
 	return resultSelector(r), nil
 }
 
@@ -15,7 +15,14 @@ func Any[Source any](source Enumerator[Source]) (bool, error) {
 	if c, ok := source.(Counter); ok {
 		return c.Count() > 0, nil
 	}
-	return source.MoveNext(), nil
+	if source.MoveNext() {
+		Close(source)
+		return true, nil
+	}
+	if err := Err(source); err != nil {
+		return false, err
+	}
+	return false, nil
 }
 
 // AnyMust is like Any but panics in case of error.
@@ -37,9 +44,13 @@ func AnyPred[Source any](source Enumerator[Source], predicate func(Source) bool)
 	}
 	for source.MoveNext() {
 		if predicate(source.Current()) {
+			Close(source)
 			return true, nil
 		}
 	}
+	if err := Err(source); err != nil {
+		return false, err
+	}
 	return false, nil
 }
 
@@ -62,9 +73,13 @@ func All[Source any](source Enumerator[Source], predicate func(Source) bool) (bo
 	}
 	for source.MoveNext() {
 		if !predicate(source.Current()) {
+			Close(source)
 			return false, nil
 		}
 	}
+	if err := Err(source); err != nil {
+		return false, err
+	}
 	return true, nil
 }
 
@@ -0,0 +1,138 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"context"
+)
+
+// ToChan creates a channel (with the specified buffer size) and sends the elements of 'en' to the channel
+// from a separate goroutine.
+// The channel is closed when 'en' is exhausted or 'ctx' is done (in the latter case 'en' is closed, see Close).
+//
+// ToChan does not report the errors encountered during iteration:
+// use Err(en) (or ctx.Err()) after the channel has been closed.
+func ToChan[T any](ctx context.Context, en Enumerator[T], buffer int) (<-chan T, error) {
+	if en == nil {
+		return nil, ErrNilSource
+	}
+	if buffer < 0 {
+		return nil, ErrNegativeCount
+	}
+	ch := make(chan T, buffer)
+	go func() {
+		defer close(ch)
+		for en.MoveNext() {
+			// cancellation takes precedence over the ready receiver
+			if ctx.Err() != nil {
+				Close(en)
+				return
+			}
+			select {
+			case <-ctx.Done():
+				Close(en)
+				return
+			case ch <- en.Current():
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// ToChanMust is like ToChan but panics in case of error.
+func ToChanMust[T any](ctx context.Context, en Enumerator[T], buffer int) <-chan T {
+	r, err := ToChan(ctx, en, buffer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SelectAsync is a pipeline stage that receives elements from 'source',
+// projects them into a new form and sends the results to the returned channel.
+//
+// The returned channel is unbuffered, so a slow consumer slows down the stage (back-pressure).
+// The returned channel is closed when 'source' is closed or 'ctx' is done.
+func SelectAsync[Source, Result any](ctx context.Context, source <-chan Source, selector func(Source) Result) (<-chan Result, error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if selector == nil {
+		return nil, ErrNilSelector
+	}
+	out := make(chan Result)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case s, open := <-source:
+				if !open {
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case out <- selector(s):
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+// SelectAsyncMust is like SelectAsync but panics in case of error.
+func SelectAsyncMust[Source, Result any](ctx context.Context, source <-chan Source, selector func(Source) Result) <-chan Result {
+	r, err := SelectAsync(ctx, source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// WhereAsync is a pipeline stage that receives elements from 'source'
+// and sends the ones satisfying 'predicate' to the returned channel.
+//
+// The returned channel is unbuffered, so a slow consumer slows down the stage (back-pressure).
+// The returned channel is closed when 'source' is closed or 'ctx' is done.
+func WhereAsync[Source any](ctx context.Context, source <-chan Source, predicate func(Source) bool) (<-chan Source, error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if predicate == nil {
+		return nil, ErrNilPredicate
+	}
+	out := make(chan Source)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case s, open := <-source:
+				if !open {
+					return
+				}
+				if !predicate(s) {
+					continue
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case out <- s:
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+// WhereAsyncMust is like WhereAsync but panics in case of error.
+func WhereAsyncMust[Source any](ctx context.Context, source <-chan Source, predicate func(Source) bool) <-chan Source {
+	r, err := WhereAsync(ctx, source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,100 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestToChan_int(t *testing.T) {
+	tests := []struct {
+		name   string
+		en     Enumerator[int]
+		buffer int
+		want   []int
+	}{
+		{name: "Unbuffered", en: RangeMust(1, 5), buffer: 0, want: []int{1, 2, 3, 4, 5}},
+		{name: "Buffered", en: RangeMust(1, 5), buffer: 3, want: []int{1, 2, 3, 4, 5}},
+		{name: "Empty", en: Empty[int](), buffer: 1, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for i := range ToChanMust(context.Background(), tt.en, tt.buffer) {
+				got = append(got, i)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToChan() = '%v', want '%v'", got, tt.want)
+			}
+		})
+	}
+	if _, err := ToChan(context.Background(), RangeMust(1, 5), -1); err != ErrNegativeCount {
+		t.Errorf("ToChan() error = '%v', want '%v'", err, ErrNegativeCount)
+	}
+}
+
+func TestToChan_cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cc := newCloseCounter(1, 2, 3, 4, 5)
+	ch := ToChanMust[int](ctx, cc, 0)
+	if got := <-ch; got != 1 {
+		t.Errorf("<-ToChan() = %v, want %v", got, 1)
+	}
+	cancel()
+	// the channel is closed after the producer goroutine has noticed the cancellation
+	for range ch {
+	}
+	if cc.closed == 0 {
+		t.Errorf("ToChan() did not close the source on cancellation")
+	}
+}
+
+func TestOnChanCtx_deadline(t *testing.T) {
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	en := NewOnChanCtx[int](ctx, ch)
+	if got := Slice[int](en); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("OnChanCtx = '%v', want '%v'", got, []int{1, 2})
+	}
+	if err := Err[int](en); err != context.DeadlineExceeded {
+		t.Errorf("Err(OnChanCtx) = '%v', want '%v'", err, context.DeadlineExceeded)
+	}
+}
+
+func TestSelectAsync_WhereAsync(t *testing.T) {
+	ctx := context.Background()
+	src := ToChanMust(ctx, RangeMust(1, 10), 0)
+	even := WhereAsyncMust(ctx, src, func(i int) bool { return i%2 == 0 })
+	squares := SelectAsyncMust(ctx, even, func(i int) int { return i * i })
+	got := Slice[int](NewOnChan(squares))
+	if want := []int{4, 16, 36, 64, 100}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SelectAsync(WhereAsync()) = '%v', want '%v'", got, want)
+	}
+	if _, err := SelectAsync[int, int](ctx, nil, Identity[int]); err != ErrNilSource {
+		t.Errorf("SelectAsync() error = '%v', want '%v'", err, ErrNilSource)
+	}
+	if _, err := WhereAsync[int](ctx, src, nil); err != ErrNilPredicate {
+		t.Errorf("WhereAsync() error = '%v', want '%v'", err, ErrNilPredicate)
+	}
+}
+
+func TestSelectAsync_cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	src := make(chan int)
+	out := SelectAsyncMust(ctx, src, Identity[int])
+	cancel()
+	select {
+	case _, open := <-out:
+		if open {
+			t.Errorf("SelectAsync() sent an element after cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Errorf("SelectAsync() did not close the channel after cancellation")
+	}
+}
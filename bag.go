@@ -0,0 +1,125 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"sync"
+)
+
+// https://en.wikipedia.org/wiki/Multiset
+// https://www.postgresql.org/docs/current/queries-union.html
+
+// bagOpKind is a kind of the multiset operation performed by bagOp
+type bagOpKind int
+
+const (
+	bagExcept bagOpKind = iota
+	bagIntersect
+	bagUnion
+)
+
+// bagCounter counts the occurrences of the keys
+type bagCounter[Key any] struct {
+	// lk maps the keys to the positions of their counts in 'counts'
+	lk     *Lookup[Key, int]
+	counts []int
+}
+
+// index returns the position of the count of 'key' or -1 if 'key' has not been counted
+func (bc *bagCounter[Key]) index(key Key) int {
+	return bc.lk.keyIndex(key)
+}
+
+// add increments the count of 'key'
+func (bc *bagCounter[Key]) add(key Key) {
+	i := bc.lk.keyIndex(key)
+	if i < 0 {
+		bc.lk.add(key, len(bc.counts))
+		bc.counts = append(bc.counts, 0)
+		i = len(bc.counts) - 1
+	}
+	bc.counts[i]++
+}
+
+// bagOp performs the multiset operation 'op' on two sequences comparing the keys of the elements.
+// 'second' is enumerated on the first MoveNext.
+//
+// bagExcept returns the elements of 'first' except the first n occurrences of each key,
+// where n is the number of occurrences of the key in 'second'.
+// bagIntersect returns the first n occurrences of each key of 'first'.
+// bagUnion returns all the elements of 'first' followed by the elements of 'second'
+// except the first m occurrences of each key, where m is the number of occurrences of the key in 'first'.
+func bagOp[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key,
+	newLookup func() *Lookup[Key, int], op bagOpKind) Enumerator[Source] {
+	var once sync.Once
+	var sl2 []Source
+	var bc *bagCounter[Key]
+	// left - the numbers of the not yet matched occurrences of the keys of 'second'
+	var left []int
+	var firstDone bool
+	// i2 - the position of the next element of 'sl2' (bagUnion)
+	var i2 int
+	var c Source
+	return OnFunc[Source]{
+		mvNxt: func() bool {
+			once.Do(func() {
+				sl2 = Slice(second)
+				bc = &bagCounter[Key]{lk: newLookup()}
+				for _, el := range sl2 {
+					bc.add(keySelector(el))
+				}
+				left = append([]int(nil), bc.counts...)
+			})
+			if Err(second) != nil {
+				return false
+			}
+			for !firstDone && first.MoveNext() {
+				c = first.Current()
+				i := bc.index(keySelector(c))
+				matched := i >= 0 && left[i] > 0
+				if matched {
+					left[i]--
+				}
+				if op == bagUnion || (op == bagExcept) != matched {
+					return true
+				}
+			}
+			if op != bagUnion || Err(first) != nil {
+				return false
+			}
+			if !firstDone {
+				firstDone = true
+				// left[i] becomes the number of the occurrences of the key in 'first' (up to its count in 'second')
+				for i := range left {
+					left[i] = bc.counts[i] - left[i]
+				}
+			}
+			for i2 < len(sl2) {
+				c = sl2[i2]
+				i2++
+				i := bc.index(keySelector(c))
+				if left[i] > 0 {
+					left[i]--
+					continue
+				}
+				return true
+			}
+			return false
+		},
+		crrnt: func() Source { return c },
+		rst: func() {
+			first.Reset()
+			if bc != nil {
+				copy(left, bc.counts)
+			}
+			firstDone, i2 = false, 0
+		},
+		err: func() error { return firstErr(Err(first), Err(second)) },
+		cls: func() error { return firstErr(Close(first), Close(second)) },
+	}
+}
+
+// lookupHashFactory returns a function that creates a Lookup using 'hasher'
+func lookupHashFactory[Key any](hasher Hasher[Key]) func() *Lookup[Key, int] {
+	return func() *Lookup[Key, int] { return newLookupHash[Key, int](hasher) }
+}
@@ -19,6 +19,8 @@ func Cast[Source, Result any](source Enumerator[Source]) (Enumerator[Result], er
 				return i.(Result)
 			},
 			rst: func() { source.Reset() },
+			err: func() error { return Err(source) },
+			cls: func() error { return Close(source) },
 		},
 		nil
 }
@@ -52,6 +54,8 @@ func OfType[Source, Result any](source Enumerator[Source]) (Enumerator[Result],
 			},
 			crrnt: func() Result { return r },
 			rst:   func() { source.Reset() },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
 		},
 		nil
 }
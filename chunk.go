@@ -21,7 +21,7 @@ func Chunk[Source any](source Enumerator[Source], size int) (Enumerator[[]Source
 						return true
 					}
 				}
-				if len(c) > 0 {
+				if len(c) > 0 && Err(source) == nil {
 					return true
 				}
 				return false
@@ -35,6 +35,8 @@ func Chunk[Source any](source Enumerator[Source], size int) (Enumerator[[]Source
 				c = make([]Source, 0, size)
 				source.Reset()
 			},
+			err: func() error { return Err(source) },
+			cls: func() error { return Close(source) },
 		},
 		nil
 }
@@ -0,0 +1,127 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"sync"
+	"time"
+)
+
+// ClockTimer represents a single event scheduled by Clock.AfterFunc.
+type ClockTimer interface {
+	// Stop prevents the timer from firing.
+	// Stop returns false if the timer has already fired or been stopped.
+	Stop() bool
+}
+
+// Clock provides the current time and schedules events for the time-based Observable operators
+// (ThrottleObs, DebounceObs, SampleObs, etc.).
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// AfterFunc waits for the duration to elapse and then calls 'f'.
+	AfterFunc(d time.Duration, f func()) ClockTimer
+}
+
+// systemClock is a Clock based on the time package
+type systemClock struct{}
+
+// Now implements the Clock.Now method.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// AfterFunc implements the Clock.AfterFunc method.
+func (systemClock) AfterFunc(d time.Duration, f func()) ClockTimer {
+	return time.AfterFunc(d, f)
+}
+
+// SystemClock is a Clock based on the time package.
+var SystemClock Clock = systemClock{}
+
+// ManualClock is a Clock whose time is advanced manually.
+// ManualClock allows to test the time-based operators deterministically.
+type ManualClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	seq    int
+	timers []*manualTimer
+}
+
+// manualTimer is a ClockTimer scheduled by ManualClock
+type manualTimer struct {
+	clock *ManualClock
+	when  time.Time
+	seq   int
+	f     func()
+}
+
+// NewManualClock creates a new ManualClock set to 'start'.
+func NewManualClock(start time.Time) *ManualClock {
+	return &ManualClock{now: start}
+}
+
+// Now implements the Clock.Now method.
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// AfterFunc implements the Clock.AfterFunc method.
+// 'f' is called by the Advance method.
+func (c *ManualClock) AfterFunc(d time.Duration, f func()) ClockTimer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seq++
+	t := &manualTimer{clock: c, when: c.now.Add(d), seq: c.seq, f: f}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// Advance moves the clock forward by 'd'.
+// The timers that become due fire in the order of their times
+// (the timers with the same time fire in the order they were scheduled),
+// the clock is set to the timer's time while the timer's function is called.
+// The timers scheduled by the timers' functions fire too if they become due.
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	target := c.now.Add(d)
+	c.mu.Unlock()
+	for {
+		c.mu.Lock()
+		i := -1
+		for j, t := range c.timers {
+			if t.when.After(target) {
+				continue
+			}
+			if i < 0 || t.when.Before(c.timers[i].when) ||
+				(t.when.Equal(c.timers[i].when) && t.seq < c.timers[i].seq) {
+				i = j
+			}
+		}
+		if i < 0 {
+			c.now = target
+			c.mu.Unlock()
+			return
+		}
+		t := c.timers[i]
+		c.timers = append(c.timers[:i], c.timers[i+1:]...)
+		c.now = t.when
+		c.mu.Unlock()
+		t.f()
+	}
+}
+
+// Stop implements the ClockTimer.Stop method.
+func (t *manualTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	for i, tt := range t.clock.timers {
+		if tt == t {
+			t.clock.timers = append(t.clock.timers[:i], t.clock.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -4,7 +4,9 @@ package go2linq
 
 import (
 	"constraints"
-	"strings"
+	"hash/maphash"
+	"unicode"
+	"unicode/utf8"
 )
 
 // Equaler defines a function to compare the objects of type T for equality.
@@ -107,7 +109,45 @@ func (cmpf ComparerFunc[T]) Compare(x, y T) int {
 	return cmpf(x, y)
 }
 
-// Order implements the Equaler, Comparer and Lesser interfaces for ordered types.
+// Hasher defines functions to hash the objects of type T and to compare them for equality.
+//
+// Equal objects must have equal hash codes.
+type Hasher[T any] interface {
+	// https://docs.microsoft.com/dotnet/api/system.collections.generic.iequalitycomparer-1.gethashcode
+
+	Equaler[T]
+
+	// Hash returns a hash code for the specified object.
+	Hash(T) uint64
+}
+
+// hasherFunc implements the Hasher interface with the help of two functions.
+type hasherFunc[T any] struct {
+	hash  func(T) uint64
+	equal func(T, T) bool
+}
+
+// Equal implements the Equaler interface.
+func (hf hasherFunc[T]) Equal(x, y T) bool {
+	return hf.equal(x, y)
+}
+
+// Hash implements the Hasher interface.
+func (hf hasherFunc[T]) Hash(x T) uint64 {
+	return hf.hash(x)
+}
+
+// NewHasher creates a new Hasher based on the provided hash and equality functions.
+//
+// E.g. having hash function hf = func(T) uint64 and equality function eqf = func(T, T) bool,
+// DistinctHash may be called in the following way:
+//
+// DistinctHash(source, NewHasher(hf, eqf))
+func NewHasher[T any](hash func(T) uint64, equal func(T, T) bool) Hasher[T] {
+	return hasherFunc[T]{hash: hash, equal: equal}
+}
+
+// Order implements the Equaler, Comparer, Lesser and Hasher interfaces for ordered types.
 type Order[T constraints.Ordered] struct{}
 
 // Equal implements the Equaler interface.
@@ -131,10 +171,26 @@ func (Order[T]) Compare(x, y T) int {
 	return 0
 }
 
+// Hash implements the Hasher interface.
+func (Order[T]) Hash(x T) uint64 {
+	return hashOrdered(x)
+}
+
 var (
 	// BoolEqualer is an Equaler for bool.
 	BoolEqualer Equaler[bool] = EqualerFunc[bool](func(x, y bool) bool { return x == y })
 
+	// BoolHasher is a Hasher for bool.
+	BoolHasher Hasher[bool] = NewHasher(
+		func(x bool) uint64 {
+			if x {
+				return hashUint64(1)
+			}
+			return hashUint64(0)
+		},
+		func(x, y bool) bool { return x == y },
+	)
+
 	// BoolLesser is a Lesser for bool.
 	BoolLesser Lesser[bool] = LesserFunc[bool](func(x, y bool) bool { return !x && y })
 
@@ -142,25 +198,65 @@ var (
 	BoolComparer Comparer[bool] = LesserFunc[bool](func(x, y bool) bool { return !x && y })
 
 	// CaseInsensitiveEqualer is a case insensitive Equaler for string.
+	// The strings are compared as if converted with strings.ToLower, but without allocations.
 	CaseInsensitiveEqualer Equaler[string] = EqualerFunc[string](func(x, y string) bool {
-		return strings.ToLower(x) == strings.ToLower(y)
+		return compareLower(x, y) == 0
 	})
 
+	// CaseInsensitiveHasher is a case insensitive Hasher for string.
+	CaseInsensitiveHasher Hasher[string] = NewHasher(
+		func(x string) uint64 { return hashMappedString(x, unicode.ToLower) },
+		CaseInsensitiveEqualer.Equal,
+	)
+
 	// CaseInsensitiveLesser is a case insensitive Lesser for string.
 	CaseInsensitiveLesser Lesser[string] = LesserFunc[string](func(x, y string) bool {
-		return strings.ToLower(x) < strings.ToLower(y)
+		return compareLower(x, y) < 0
 	})
 
 	// CaseInsensitiveComparer is a case insensitive Comparer for string.
-	CaseInsensitiveComparer Comparer[string] = ComparerFunc[string](func(x, y string) int {
-		sx := strings.ToLower(x)
-		sy := strings.ToLower(y)
-		if sx < sy {
-			return -1
-		}
-		if sx > sy {
-			return +1
-		}
-		return 0
-	})
+	CaseInsensitiveComparer Comparer[string] = ComparerFunc[string](compareLower)
 )
+
+// compareMapped compares the strings rune by rune after mapping the runes with 'mapping'
+// (invalid UTF-8 bytes are treated as utf8.RuneError, as strings.Map does)
+func compareMapped(x, y string, mapping func(rune) rune) int {
+	for len(x) > 0 && len(y) > 0 {
+		rx, nx := utf8.DecodeRuneInString(x)
+		ry, ny := utf8.DecodeRuneInString(y)
+		if rx != ry {
+			rx, ry = mapping(rx), mapping(ry)
+			if rx < ry {
+				return -1
+			}
+			if rx > ry {
+				return +1
+			}
+		}
+		x, y = x[nx:], y[ny:]
+	}
+	switch {
+	case len(x) > 0:
+		return +1
+	case len(y) > 0:
+		return -1
+	}
+	return 0
+}
+
+// hashMappedString hashes 's' as hashString(strings.Map(mapping, s)) does, but without allocations
+func hashMappedString(s string, mapping func(rune) rune) uint64 {
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+	var buf [utf8.UTFMax]byte
+	for _, r := range s {
+		n := utf8.EncodeRune(buf[:], mapping(r))
+		h.Write(buf[:n])
+	}
+	return h.Sum64()
+}
+
+// compareLower compares strings.ToLower(x) and strings.ToLower(y)
+func compareLower(x, y string) int {
+	return compareMapped(x, y, unicode.ToLower)
+}
@@ -0,0 +1,110 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"hash/maphash"
+	"sync"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// https://docs.microsoft.com/dotnet/api/system.stringcomparer.create
+// https://unicode.org/reports/tr10/
+
+// normalize returns the NFC form of 's' (without allocation if 's' is already normalized)
+func normalize(s string) string {
+	if norm.NFC.IsNormalString(s) {
+		return s
+	}
+	return norm.NFC.String(s)
+}
+
+var (
+	// NormalizedEqualer is an Equaler for string that compares the strings' NFC forms,
+	// so canonically equivalent strings (e.g. "é" and "é") are equal.
+	NormalizedEqualer Equaler[string] = EqualerFunc[string](func(x, y string) bool {
+		return x == y || normalize(x) == normalize(y)
+	})
+
+	// NormalizedHasher is a Hasher for string that hashes the strings' NFC forms.
+	NormalizedHasher Hasher[string] = NewHasher(
+		func(x string) uint64 { return hashString(normalize(x)) },
+		NormalizedEqualer.Equal,
+	)
+
+	// NormalizedLesser is a Lesser for string that compares the strings' NFC forms.
+	NormalizedLesser Lesser[string] = LesserFunc[string](func(x, y string) bool {
+		return normalize(x) < normalize(y)
+	})
+
+	// NormalizedComparer is a Comparer for string that compares the strings' NFC forms.
+	NormalizedComparer Comparer[string] = ComparerFunc[string](func(x, y string) int {
+		return Order[string]{}.Compare(normalize(x), normalize(y))
+	})
+)
+
+// Collation compares strings according to the rules of a language
+// (see https://pkg.go.dev/golang.org/x/text/collate).
+// Collation implements the Equaler, Lesser, Comparer and Hasher interfaces
+// and is safe for concurrent use.
+//
+// Collation is the counterpart of the culture-sensitive .NET's StringComparer, e.g.:
+//
+// NewCollation(language.Turkish, collate.IgnoreCase)
+//
+// corresponds to StringComparer.Create(new CultureInfo("tr-TR"), true).
+type Collation struct {
+	tag  language.Tag
+	opts []collate.Option
+	// pool - the pool of *collator (collate.Collator is not safe for concurrent use)
+	pool sync.Pool
+}
+
+// collator is a collate.Collator with its buffer for the collation keys
+type collator struct {
+	c   *collate.Collator
+	buf collate.Buffer
+}
+
+// NewCollation creates a new Collation for the language specified by 'tag'.
+// 'opts' (e.g. collate.IgnoreCase, collate.Loose, collate.Numeric) customize the comparison.
+// Note that "ß" and "ss" are equal only under collate.Loose (which ignores case, diacritics and width).
+func NewCollation(tag language.Tag, opts ...collate.Option) *Collation {
+	c := &Collation{tag: tag, opts: opts}
+	c.pool.New = func() any {
+		return &collator{c: collate.New(c.tag, c.opts...)}
+	}
+	return c
+}
+
+// Compare implements the Comparer interface.
+func (c *Collation) Compare(x, y string) int {
+	cl := c.pool.Get().(*collator)
+	defer c.pool.Put(cl)
+	return cl.c.CompareString(x, y)
+}
+
+// Equal implements the Equaler interface.
+func (c *Collation) Equal(x, y string) bool {
+	return c.Compare(x, y) == 0
+}
+
+// Less implements the Lesser interface.
+func (c *Collation) Less(x, y string) bool {
+	return c.Compare(x, y) < 0
+}
+
+// Hash implements the Hasher interface.
+// The hash code is computed from the collation key, so the strings equal under Collation have equal hash codes.
+func (c *Collation) Hash(x string) uint64 {
+	cl := c.pool.Get().(*collator)
+	defer c.pool.Put(cl)
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+	h.Write(cl.c.KeyFromString(&cl.buf, x))
+	cl.buf.Reset()
+	return h.Sum64()
+}
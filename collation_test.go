@@ -0,0 +1,113 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"sync"
+	"testing"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+func Test_NormalizedComparer(t *testing.T) {
+	// "é" as a single code point and as 'e' followed by the combining acute accent
+	composed, decomposed := "café", "café"
+	if composed == decomposed {
+		t.Fatal("test strings must differ")
+	}
+	if !NormalizedEqualer.Equal(composed, decomposed) {
+		t.Errorf("NormalizedEqualer.Equal(%q, %q) = false, want true", composed, decomposed)
+	}
+	if NormalizedEqualer.Equal(composed, "cafe") {
+		t.Errorf("NormalizedEqualer.Equal(%q, %q) = true, want false", composed, "cafe")
+	}
+	if c := NormalizedComparer.Compare(composed, decomposed); c != 0 {
+		t.Errorf("NormalizedComparer.Compare(%q, %q) = %v, want 0", composed, decomposed, c)
+	}
+	if NormalizedLesser.Less(decomposed, composed) {
+		t.Errorf("NormalizedLesser.Less(%q, %q) = true, want false", decomposed, composed)
+	}
+	if NormalizedHasher.Hash(composed) != NormalizedHasher.Hash(decomposed) {
+		t.Errorf("NormalizedHasher.Hash(%q) != NormalizedHasher.Hash(%q)", composed, decomposed)
+	}
+	got := DistinctHashMust(NewOnSliceEn(composed, decomposed, "cafe"), NormalizedHasher)
+	want := NewOnSliceEn(composed, "cafe")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("DistinctHash() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_Collation(t *testing.T) {
+	tests := []struct {
+		name string
+		cl   *Collation
+		x, y string
+		want int
+	}{
+		{name: "EnglishCase", cl: NewCollation(language.English), x: "a", y: "B", want: -1},
+		{name: "EnglishAccent", cl: NewCollation(language.English), x: "cote", y: "côte", want: -1},
+		{name: "EnglishIgnoreCase", cl: NewCollation(language.English, collate.IgnoreCase), x: "Hello", y: "hELLO", want: 0},
+		{name: "GermanSharpS", cl: NewCollation(language.German, collate.Loose), x: "Straße", y: "STRASSE", want: 0},
+		{name: "TurkishDottedI", cl: NewCollation(language.Turkish, collate.IgnoreCase), x: "İstanbul", y: "istanbul", want: 0},
+		{name: "TurkishDotlessI", cl: NewCollation(language.Turkish, collate.IgnoreCase), x: "ılık", y: "ILIK", want: 0},
+		{name: "TurkishDotlessNotDotted", cl: NewCollation(language.Turkish, collate.IgnoreCase), x: "ılık", y: "ilik", want: -1},
+		{name: "Loose", cl: NewCollation(language.French, collate.Loose), x: "Cote", y: "côte", want: 0},
+		{name: "Numeric", cl: NewCollation(language.English, collate.Numeric), x: "file2", y: "file10", want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cl.Compare(tt.x, tt.y); got != tt.want {
+				t.Errorf("Collation.Compare(%q, %q) = %v, want %v", tt.x, tt.y, got, tt.want)
+			}
+			if got := tt.cl.Equal(tt.x, tt.y); got != (tt.want == 0) {
+				t.Errorf("Collation.Equal(%q, %q) = %v, want %v", tt.x, tt.y, got, tt.want == 0)
+			}
+			if got := tt.cl.Less(tt.x, tt.y); got != (tt.want < 0) {
+				t.Errorf("Collation.Less(%q, %q) = %v, want %v", tt.x, tt.y, got, tt.want < 0)
+			}
+			if tt.want == 0 && tt.cl.Hash(tt.x) != tt.cl.Hash(tt.y) {
+				t.Errorf("Collation.Hash(%q) != Collation.Hash(%q)", tt.x, tt.y)
+			}
+		})
+	}
+}
+
+func Test_Collation_operators(t *testing.T) {
+	swedish := NewCollation(language.Swedish)
+	got := OrderByCmpMust(NewOnSliceEn("ö", "z", "å", "a", "ä"), Identity[string], swedish).GetEnumerator()
+	want := NewOnSliceEn("a", "z", "å", "ä", "ö")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("OrderByCmp() = '%v', want '%v'", String(got), String(want))
+	}
+	german := NewCollation(language.German, collate.Loose)
+	got = DistinctEqMust(NewOnSliceEn("Straße", "STRASSE", "strasse", "Gasse"), Equaler[string](german))
+	want = NewOnSliceEn("Straße", "Gasse")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("DistinctEq() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_Collation_concurrent(t *testing.T) {
+	cl := NewCollation(language.English, collate.IgnoreCase)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if cl.Compare("Hello", "hello") != 0 || cl.Hash("Hello") != cl.Hash("hello") {
+					t.Error("Collation is not consistent under concurrent use")
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,375 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"math"
+	"math/big"
+	"sync"
+)
+
+// https://morelinq.github.io/3.3/ref/api/html/M_MoreLinq_MoreEnumerable_Permutations__1.htm
+// https://morelinq.github.io/3.3/ref/api/html/Overload_MoreLinq_MoreEnumerable_Subsets.htm
+// https://morelinq.github.io/3.3/ref/api/html/M_MoreLinq_MoreEnumerable_Cartesian__3.htm
+// https://en.wikipedia.org/wiki/Combination
+
+// onFuncCount is an OnFunc that implements the Counter interface
+type onFuncCount[T any] struct {
+	OnFunc[T]
+	count func() int
+}
+
+// Count implements the Counter interface.
+func (en onFuncCount[T]) Count() int {
+	return en.count()
+}
+
+// satInt converts 'b' to int, the values exceeding math.MaxInt are converted to math.MaxInt
+func satInt(b *big.Int) int {
+	if !b.IsInt64() || b.Int64() > math.MaxInt {
+		return math.MaxInt
+	}
+	return int(b.Int64())
+}
+
+// indexRows returns the rows of the elements of 'source' selected by the index tuples.
+// 'source' is buffered on the first MoveNext.
+// 'next' returns the tuple following 'idx' (the first tuple if 'idx' is nil) for 'n' elements
+// or false if there are no more tuples, 'next' may modify and return 'idx'.
+// If 'count' is not nil and 'source' implements Counter,
+// the result implements Counter, 'count' computes the number of rows for 'n' elements.
+func indexRows[T any](source Enumerator[T], next func(idx []int, n int) ([]int, bool), count func(n int) *big.Int) Enumerator[[]T] {
+	var once sync.Once
+	var sl []T
+	var idx []int
+	var done bool
+	en := OnFunc[[]T]{
+		mvNxt: func() bool {
+			once.Do(func() {
+				sl = Slice(source)
+				Close(source)
+			})
+			if done || Err(source) != nil {
+				return false
+			}
+			var ok bool
+			if idx, ok = next(idx, len(sl)); !ok {
+				idx, done = nil, true
+				return false
+			}
+			return true
+		},
+		crrnt: func() []T {
+			if idx == nil {
+				return nil
+			}
+			row := make([]T, len(idx))
+			for j, i := range idx {
+				row[j] = sl[i]
+			}
+			return row
+		},
+		rst: func() {
+			idx, done = nil, false
+		},
+		err: func() error { return Err(source) },
+		cls: func() error { return Close(source) },
+	}
+	if c, ok := source.(Counter); ok && count != nil {
+		return onFuncCount[[]T]{OnFunc: en, count: func() int { return satInt(count(c.Count())) }}
+	}
+	return en
+}
+
+// nextPermutation returns the next permutation of 'n' indexes in lexicographic order
+func nextPermutation(idx []int, n int) ([]int, bool) {
+	if idx == nil {
+		idx = make([]int, n)
+		for i := range idx {
+			idx[i] = i
+		}
+		return idx, true
+	}
+	i := n - 2
+	for i >= 0 && idx[i] > idx[i+1] {
+		i--
+	}
+	if i < 0 {
+		return idx, false
+	}
+	j := n - 1
+	for idx[j] < idx[i] {
+		j--
+	}
+	idx[i], idx[j] = idx[j], idx[i]
+	for l, r := i+1, n-1; l < r; l, r = l+1, r-1 {
+		idx[l], idx[r] = idx[r], idx[l]
+	}
+	return idx, true
+}
+
+// Permutations returns all the permutations of the elements of a sequence.
+// The elements are distinguished by their positions, so the equal elements produce the equal permutations.
+// The permutations are returned in lexicographic order of the elements' positions,
+// an empty sequence has the single empty permutation.
+// 'source' is buffered on the first call to MoveNext, the permutations are generated lazily,
+// each permutation is a separate slice.
+// If 'source' implements Counter, the result implements Counter (the number of permutations is capped at math.MaxInt).
+func Permutations[Source any](source Enumerator[Source]) (Enumerator[[]Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	return indexRows(source, nextPermutation, func(n int) *big.Int {
+		return new(big.Int).MulRange(1, int64(n))
+	}), nil
+}
+
+// PermutationsMust is like Permutations but panics in case of error.
+func PermutationsMust[Source any](source Enumerator[Source]) Enumerator[[]Source] {
+	r, err := Permutations(source)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// nextCombination returns the next increasing tuple of 'k' indexes less than 'n' in lexicographic order
+func nextCombination(idx []int, n, k int) ([]int, bool) {
+	if idx == nil {
+		if k > n {
+			return nil, false
+		}
+		idx = make([]int, k)
+		for i := range idx {
+			idx[i] = i
+		}
+		return idx, true
+	}
+	i := k - 1
+	for i >= 0 && idx[i] == n-k+i {
+		i--
+	}
+	if i < 0 {
+		return idx, false
+	}
+	idx[i]++
+	for j := i + 1; j < k; j++ {
+		idx[j] = idx[j-1] + 1
+	}
+	return idx, true
+}
+
+// Combinations returns all the combinations of 'k' elements of a sequence (without repetition).
+// The elements of each combination keep their order in 'source',
+// the combinations are returned in lexicographic order of the elements' positions.
+// If 'k' is greater than the number of elements, the result is empty, if 'k' is 0, the result has the single empty combination.
+// 'source' is buffered on the first call to MoveNext, the combinations are generated lazily,
+// each combination is a separate slice.
+// If 'source' implements Counter, the result implements Counter (the number of combinations is capped at math.MaxInt).
+func Combinations[Source any](source Enumerator[Source], k int) (Enumerator[[]Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if k < 0 {
+		return nil, ErrNegativeCount
+	}
+	return indexRows(source,
+			func(idx []int, n int) ([]int, bool) { return nextCombination(idx, n, k) },
+			func(n int) *big.Int { return new(big.Int).Binomial(int64(n), int64(k)) }),
+		nil
+}
+
+// CombinationsMust is like Combinations but panics in case of error.
+func CombinationsMust[Source any](source Enumerator[Source], k int) Enumerator[[]Source] {
+	r, err := Combinations(source, k)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// CombinationsWithRepetition returns all the combinations of 'k' elements of a sequence
+// where each element may be chosen more than once (i.e. the multisets of size 'k').
+// The combinations are returned in lexicographic order of the elements' positions
+// (see Combinations).
+func CombinationsWithRepetition[Source any](source Enumerator[Source], k int) (Enumerator[[]Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if k < 0 {
+		return nil, ErrNegativeCount
+	}
+	next := func(idx []int, n int) ([]int, bool) {
+		if idx == nil {
+			if n == 0 && k > 0 {
+				return nil, false
+			}
+			return make([]int, k), true
+		}
+		i := k - 1
+		for i >= 0 && idx[i] == n-1 {
+			i--
+		}
+		if i < 0 {
+			return idx, false
+		}
+		idx[i]++
+		for j := i + 1; j < k; j++ {
+			idx[j] = idx[i]
+		}
+		return idx, true
+	}
+	return indexRows(source, next, func(n int) *big.Int {
+			if n == 0 {
+				if k == 0 {
+					return big.NewInt(1)
+				}
+				return big.NewInt(0)
+			}
+			return new(big.Int).Binomial(int64(n+k-1), int64(k))
+		}),
+		nil
+}
+
+// CombinationsWithRepetitionMust is like CombinationsWithRepetition but panics in case of error.
+func CombinationsWithRepetitionMust[Source any](source Enumerator[Source], k int) Enumerator[[]Source] {
+	r, err := CombinationsWithRepetition(source, k)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Subsets returns all the subsets of the elements of a sequence (the power set).
+// The subsets are returned in order of their sizes (starting with the empty subset),
+// the subsets of the same size are returned as Combinations does.
+// 'source' is buffered on the first call to MoveNext, the subsets are generated lazily,
+// each subset is a separate slice.
+// If 'source' implements Counter, the result implements Counter (the number of subsets is capped at math.MaxInt).
+func Subsets[Source any](source Enumerator[Source]) (Enumerator[[]Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	next := func(idx []int, n int) ([]int, bool) {
+		if idx == nil {
+			return nextCombination(nil, n, 0)
+		}
+		if idx, ok := nextCombination(idx, n, len(idx)); ok {
+			return idx, true
+		}
+		return nextCombination(nil, n, len(idx)+1)
+	}
+	return indexRows(source, next, func(n int) *big.Int {
+		return new(big.Int).Lsh(big.NewInt(1), uint(n))
+	}), nil
+}
+
+// SubsetsMust is like Subsets but panics in case of error.
+func SubsetsMust[Source any](source Enumerator[Source]) Enumerator[[]Source] {
+	r, err := Subsets(source)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// CartesianProduct returns the Cartesian product of the sequences:
+// each row contains one element of each sequence (in order of the sequences),
+// the rows are returned in lexicographic order of the elements' positions (the last sequence varies fastest).
+// If any of the sequences is empty, the result is empty, if no sequences are specified, the result has the single empty row.
+// The sequences are buffered on the first call to MoveNext, the rows are generated lazily, each row is a separate slice.
+// If all the sequences implement Counter, the result implements Counter (the number of rows is capped at math.MaxInt).
+// The sequences must not be based on the same Enumerator.
+func CartesianProduct[Source any](sources ...Enumerator[Source]) (Enumerator[[]Source], error) {
+	for _, source := range sources {
+		if source == nil {
+			return nil, ErrNilSource
+		}
+	}
+	var once sync.Once
+	sls := make([][]Source, len(sources))
+	var idx []int
+	var done bool
+	var err error
+	en := OnFunc[[]Source]{
+		mvNxt: func() bool {
+			once.Do(func() {
+				for i, source := range sources {
+					sls[i] = Slice(source)
+					if err = Err(source); err != nil {
+						return
+					}
+				}
+			})
+			if done || err != nil {
+				return false
+			}
+			if idx == nil {
+				for _, sl := range sls {
+					if len(sl) == 0 {
+						done = true
+						return false
+					}
+				}
+				idx = make([]int, len(sls))
+				return true
+			}
+			i := len(idx) - 1
+			for i >= 0 && idx[i] == len(sls[i])-1 {
+				idx[i] = 0
+				i--
+			}
+			if i < 0 {
+				idx, done = nil, true
+				return false
+			}
+			idx[i]++
+			return true
+		},
+		crrnt: func() []Source {
+			if idx == nil {
+				return nil
+			}
+			row := make([]Source, len(idx))
+			for j, i := range idx {
+				row[j] = sls[j][i]
+			}
+			return row
+		},
+		rst: func() {
+			idx, done = nil, false
+		},
+		err: func() error { return err },
+		cls: func() error {
+			var cerr error
+			for _, source := range sources {
+				cerr = firstErr(cerr, Close(source))
+			}
+			return cerr
+		},
+	}
+	cc := make([]Counter, len(sources))
+	for i, source := range sources {
+		c, ok := source.(Counter)
+		if !ok {
+			return en, nil
+		}
+		cc[i] = c
+	}
+	return onFuncCount[[]Source]{OnFunc: en, count: func() int {
+		r := big.NewInt(1)
+		for _, c := range cc {
+			r.Mul(r, big.NewInt(int64(c.Count())))
+		}
+		return satInt(r)
+	}}, nil
+}
+
+// CartesianProductMust is like CartesianProduct but panics in case of error.
+func CartesianProductMust[Source any](sources ...Enumerator[Source]) Enumerator[[]Source] {
+	r, err := CartesianProduct(sources...)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
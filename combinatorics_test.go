@@ -0,0 +1,105 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// rowsString returns the rows as a single string (e.g. "[1 2] [2 1]")
+func rowsString[T any](en Enumerator[[]T]) string {
+	s := ""
+	for en.MoveNext() {
+		if s != "" {
+			s += " "
+		}
+		s += fmt.Sprint(en.Current())
+	}
+	return s
+}
+
+func Test_Combinatorics(t *testing.T) {
+	tests := []struct {
+		name      string
+		got       Enumerator[[]int]
+		want      string
+		wantCount int
+	}{
+		{name: "PermutationsEmpty", got: PermutationsMust(NewOnSliceEn[int]()), want: "[]", wantCount: 1},
+		{name: "Permutations",
+			got:       PermutationsMust(NewOnSliceEn(1, 2, 3)),
+			want:      "[1 2 3] [1 3 2] [2 1 3] [2 3 1] [3 1 2] [3 2 1]",
+			wantCount: 6,
+		},
+		{name: "PermutationsDuplicates", got: PermutationsMust(NewOnSliceEn(1, 1)), want: "[1 1] [1 1]", wantCount: 2},
+		{name: "Combinations", got: CombinationsMust(NewOnSliceEn(1, 2, 3, 4), 2),
+			want:      "[1 2] [1 3] [1 4] [2 3] [2 4] [3 4]",
+			wantCount: 6,
+		},
+		{name: "CombinationsZero", got: CombinationsMust(NewOnSliceEn(1, 2), 0), want: "[]", wantCount: 1},
+		{name: "CombinationsTooMany", got: CombinationsMust(NewOnSliceEn(1, 2), 3), want: "", wantCount: 0},
+		{name: "CombinationsWithRepetition", got: CombinationsWithRepetitionMust(NewOnSliceEn(1, 2, 3), 2),
+			want:      "[1 1] [1 2] [1 3] [2 2] [2 3] [3 3]",
+			wantCount: 6,
+		},
+		{name: "CombinationsWithRepetitionEmpty", got: CombinationsWithRepetitionMust(NewOnSliceEn[int](), 2), want: "", wantCount: 0},
+		{name: "Subsets", got: SubsetsMust(NewOnSliceEn(1, 2, 3)),
+			want:      "[] [1] [2] [3] [1 2] [1 3] [2 3] [1 2 3]",
+			wantCount: 8,
+		},
+		{name: "CartesianProduct", got: CartesianProductMust(NewOnSliceEn(1, 2), NewOnSliceEn(3), NewOnSliceEn(4, 5)),
+			want:      "[1 3 4] [1 3 5] [2 3 4] [2 3 5]",
+			wantCount: 4,
+		},
+		{name: "CartesianProductEmpty", got: CartesianProductMust(NewOnSliceEn(1, 2), NewOnSliceEn[int]()), want: "", wantCount: 0},
+		{name: "CartesianProductNone", got: CartesianProductMust[int](), want: "[]", wantCount: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var count int
+			if !TryGetNonEnumeratedCountMust(tt.got, &count) {
+				t.Errorf("%s does not implement Counter", tt.name)
+			} else if count != tt.wantCount {
+				t.Errorf("%s.Count() = %v, want %v", tt.name, count, tt.wantCount)
+			}
+			if got := rowsString(tt.got); got != tt.want {
+				t.Errorf("%s = '%v', want '%v'", tt.name, got, tt.want)
+			}
+			tt.got.Reset()
+			if got := rowsString(tt.got); got != tt.want {
+				t.Errorf("%s after Reset = '%v', want '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Combinatorics_lazy(t *testing.T) {
+	// 20! permutations are far too many to materialize
+	got := PermutationsMust(RangeMust(1, 20))
+	if _, ok := got.(Counter); ok {
+		t.Errorf("Permutations() of non-Counter implements Counter")
+	}
+	got = TakeMust(got, 2)
+	if s := rowsString(got); s != "[1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20] [1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 20 19]" {
+		t.Errorf("Permutations() = '%v'", s)
+	}
+	var count int
+	TryGetNonEnumeratedCountMust(PermutationsMust(NewOnSliceEn(make([]int, 30)...)), &count)
+	if count != math.MaxInt {
+		t.Errorf("Permutations().Count() = %v, want %v", count, math.MaxInt)
+	}
+}
+
+func Test_Combinatorics_errors(t *testing.T) {
+	if _, err := Combinations(NewOnSliceEn(1), -1); err != ErrNegativeCount {
+		t.Errorf("Combinations() error = '%v', want '%v'", err, ErrNegativeCount)
+	}
+	if _, err := CartesianProduct(NewOnSliceEn(1), nil); err != ErrNilSource {
+		t.Errorf("CartesianProduct() error = '%v', want '%v'", err, ErrNilSource)
+	}
+	if _, err := SliceErr(SubsetsMust[int](failAt(NewOnSliceEn(1, 2), 2))); err != errTest {
+		t.Errorf("Subsets() error = '%v', want '%v'", err, errTest)
+	}
+}
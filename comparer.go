@@ -0,0 +1,257 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// https://docs.microsoft.com/dotnet/api/system.collections.generic.comparer-1.create
+
+// Then returns a Comparer that compares the objects using 'cmpf'
+// and then, if the objects are equal, using 'next'.
+// Then panics with ErrNilComparer if 'next' is nil.
+func (cmpf ComparerFunc[T]) Then(next Comparer[T]) ComparerFunc[T] {
+	if next == nil {
+		panic(ErrNilComparer)
+	}
+	return func(x, y T) int {
+		if c := cmpf(x, y); c != 0 {
+			return c
+		}
+		return next.Compare(x, y)
+	}
+}
+
+// Reverse returns a Comparer that compares the objects in the reverse order.
+func (cmpf ComparerFunc[T]) Reverse() ComparerFunc[T] {
+	return func(x, y T) int {
+		return cmpf(y, x)
+	}
+}
+
+// ComparerBy returns a Comparer that compares the objects by the keys extracted with 'keySelector'
+// using a specified Comparer.
+//
+// The result may be composed with Then and Reverse, e.g.:
+//
+// ComparerByMust(age, Order[int]{}).Reverse().Then(ComparerByMust(name, Order[string]{}))
+func ComparerBy[T, Key any](keySelector func(T) Key, comparer Comparer[Key]) (ComparerFunc[T], error) {
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return func(x, y T) int {
+		return comparer.Compare(keySelector(x), keySelector(y))
+	}, nil
+}
+
+// ComparerByMust is like ComparerBy but panics in case of error.
+func ComparerByMust[T, Key any](keySelector func(T) Key, comparer Comparer[Key]) ComparerFunc[T] {
+	r, err := ComparerBy(keySelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// nullsCmp returns a Comparer for pointers, nil pointers are less than non-nil ones if 'nilLess' is true
+func nullsCmp[T any](comparer Comparer[T], nilLess bool) (ComparerFunc[*T], error) {
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	nilCmp := +1
+	if nilLess {
+		nilCmp = -1
+	}
+	return func(x, y *T) int {
+		switch {
+		case x == nil && y == nil:
+			return 0
+		case x == nil:
+			return nilCmp
+		case y == nil:
+			return -nilCmp
+		}
+		return comparer.Compare(*x, *y)
+	}, nil
+}
+
+// NullsFirst returns a Comparer for pointers that places nil pointers before non-nil ones.
+// Non-nil pointers are compared by the values they point to using a specified Comparer.
+func NullsFirst[T any](comparer Comparer[T]) (ComparerFunc[*T], error) {
+	return nullsCmp(comparer, true)
+}
+
+// NullsFirstMust is like NullsFirst but panics in case of error.
+func NullsFirstMust[T any](comparer Comparer[T]) ComparerFunc[*T] {
+	r, err := NullsFirst(comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// NullsLast returns a Comparer for pointers that places nil pointers after non-nil ones.
+// Non-nil pointers are compared by the values they point to using a specified Comparer.
+func NullsLast[T any](comparer Comparer[T]) (ComparerFunc[*T], error) {
+	return nullsCmp(comparer, false)
+}
+
+// NullsLastMust is like NullsLast but panics in case of error.
+func NullsLastMust[T any](comparer Comparer[T]) ComparerFunc[*T] {
+	r, err := NullsLast(comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ComparerFromSpec returns a Comparer for structs (or pointers to structs) built from an ordering spec.
+//
+// The spec is a comma-separated list of the struct fields, each optionally followed by
+// "asc" (default) or "desc" (case-insensitive), e.g. "Age desc, Name asc".
+// The nested fields are specified with dots, e.g. "Address.City".
+// The fields must be of boolean, integer, floating-point or string kinds or pointers to them.
+// nil pointers (both the compared objects and the fields) are less than non-nil ones.
+// ErrInvalidSpec is returned (wrapped) if the spec does not match T.
+func ComparerFromSpec[T any](spec string) (ComparerFunc[T], error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %v is not a struct", ErrInvalidSpec, t)
+	}
+	var keys []specKey
+	for _, part := range strings.Split(spec, ",") {
+		k, err := parseSpecKey(t, part)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	return func(x, y T) int {
+		vx := reflect.ValueOf(&x).Elem()
+		vy := reflect.ValueOf(&y).Elem()
+		for _, k := range keys {
+			c := compareSpecValues(k.value(vx), k.value(vy))
+			if k.desc {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	}, nil
+}
+
+// ComparerFromSpecMust is like ComparerFromSpec but panics in case of error.
+func ComparerFromSpecMust[T any](spec string) ComparerFunc[T] {
+	r, err := ComparerFromSpec[T](spec)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// specKey is a single key of an ordering spec
+type specKey struct {
+	// path - the indexes of the (nested) field
+	path [][]int
+	desc bool
+}
+
+// parseSpecKey parses a single key of an ordering spec for the struct type 't'
+func parseSpecKey(t reflect.Type, part string) (specKey, error) {
+	ff := strings.Fields(part)
+	if len(ff) == 0 || len(ff) > 2 {
+		return specKey{}, fmt.Errorf("%w: '%s'", ErrInvalidSpec, strings.TrimSpace(part))
+	}
+	var k specKey
+	if len(ff) == 2 {
+		switch strings.ToLower(ff[1]) {
+		case "asc", "ascending":
+		case "desc", "descending":
+			k.desc = true
+		default:
+			return specKey{}, fmt.Errorf("%w: unknown direction '%s'", ErrInvalidSpec, ff[1])
+		}
+	}
+	ft := t
+	for _, name := range strings.Split(ff[0], ".") {
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if ft.Kind() != reflect.Struct {
+			return specKey{}, fmt.Errorf("%w: '%s' is not a struct field", ErrInvalidSpec, ff[0])
+		}
+		sf, ok := ft.FieldByName(name)
+		if !ok {
+			return specKey{}, fmt.Errorf("%w: no field '%s' in %v", ErrInvalidSpec, name, ft)
+		}
+		k.path = append(k.path, sf.Index)
+		ft = sf.Type
+	}
+	if ft.Kind() == reflect.Pointer {
+		ft = ft.Elem()
+	}
+	switch ft.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String:
+	default:
+		return specKey{}, fmt.Errorf("%w: field '%s' of unsupported type %v", ErrInvalidSpec, ff[0], ft)
+	}
+	return k, nil
+}
+
+// value returns the key's field of 'v' or invalid Value if a nil pointer is encountered
+func (k specKey) value(v reflect.Value) reflect.Value {
+	for _, idx := range k.path {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		}
+		v = v.FieldByIndex(idx)
+	}
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// compareSpecValues compares two values of the same kind, invalid values are less than valid ones
+func compareSpecValues(x, y reflect.Value) int {
+	switch {
+	case !x.IsValid() && !y.IsValid():
+		return 0
+	case !x.IsValid():
+		return -1
+	case !y.IsValid():
+		return +1
+	}
+	switch x.Kind() {
+	case reflect.Bool:
+		return BoolComparer.Compare(x.Bool(), y.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Order[int64]{}.Compare(x.Int(), y.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Order[uint64]{}.Compare(x.Uint(), y.Uint())
+	case reflect.Float32, reflect.Float64:
+		return Order[float64]{}.Compare(x.Float(), y.Float())
+	default:
+		return Order[string]{}.Compare(x.String(), y.String())
+	}
+}
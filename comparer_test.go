@@ -0,0 +1,186 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"errors"
+	"testing"
+)
+
+type specAddress struct {
+	City string
+}
+
+type specPerson struct {
+	Name    string
+	Age     int
+	Score   *float64
+	Address *specAddress
+}
+
+func Test_ComparerBy_Then_Reverse(t *testing.T) {
+	byAge := ComparerByMust(func(p specPerson) int { return p.Age }, Order[int]{})
+	byName := ComparerByMust(func(p specPerson) string { return p.Name }, Order[string]{})
+	pp := []specPerson{{Name: "b", Age: 1}, {Name: "a", Age: 2}, {Name: "c", Age: 1}, {Name: "a", Age: 1}}
+	tests := []struct {
+		name string
+		cmp  Comparer[specPerson]
+		want []string
+	}{
+		{name: "AgeName", cmp: byAge.Then(byName), want: []string{"a1", "b1", "c1", "a2"}},
+		{name: "AgeDescName", cmp: byAge.Reverse().Then(byName), want: []string{"a2", "a1", "b1", "c1"}},
+		{name: "NameAgeDesc", cmp: byName.Then(byAge.Reverse()), want: []string{"a2", "a1", "b1", "c1"}},
+		{name: "Reverse", cmp: byAge.Then(byName).Reverse(), want: []string{"a2", "c1", "b1", "a1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SelectMust(
+				OrderByCmpMust(NewOnSliceEn(pp...), Identity[specPerson], tt.cmp).GetEnumerator(),
+				func(p specPerson) string { return p.Name + string(rune('0'+p.Age)) },
+			)
+			want := NewOnSliceEn(tt.want...)
+			if !SequenceEqualMust(got, want) {
+				got.Reset()
+				want.Reset()
+				t.Errorf("OrderByCmp() = '%v', want '%v'", String(got), String(want))
+			}
+		})
+	}
+}
+
+func Test_ComparerBy_errors(t *testing.T) {
+	if _, err := ComparerBy[specPerson, int](nil, Order[int]{}); err != ErrNilSelector {
+		t.Errorf("ComparerBy() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+	if _, err := ComparerBy[specPerson, int](func(p specPerson) int { return p.Age }, nil); err != ErrNilComparer {
+		t.Errorf("ComparerBy() error = '%v', want '%v'", err, ErrNilComparer)
+	}
+	if _, err := NullsFirst[int](nil); err != ErrNilComparer {
+		t.Errorf("NullsFirst() error = '%v', want '%v'", err, ErrNilComparer)
+	}
+}
+
+func Test_NullsFirst_NullsLast(t *testing.T) {
+	one, two := 1, 2
+	tests := []struct {
+		name string
+		cmp  Comparer[*int]
+		want []*int
+	}{
+		{name: "NullsFirst", cmp: NullsFirstMust[int](Order[int]{}), want: []*int{nil, nil, &one, &two}},
+		{name: "NullsLast", cmp: NullsLastMust[int](Order[int]{}), want: []*int{&one, &two, nil, nil}},
+		{name: "NullsFirstDesc", cmp: NullsFirstMust[int](Order[int]{}).Reverse(), want: []*int{&two, &one, nil, nil}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := OrderByCmpMust(NewOnSliceEn(&two, nil, &one, nil), Identity[*int], tt.cmp).GetEnumerator()
+			want := NewOnSliceEn(tt.want...)
+			if !SequenceEqualMust(got, want) {
+				got.Reset()
+				want.Reset()
+				t.Errorf("OrderByCmp() = '%v', want '%v'", String(got), String(want))
+			}
+		})
+	}
+}
+
+func Test_ComparerFromSpec(t *testing.T) {
+	f1, f2 := 1.5, 2.5
+	pp := []specPerson{
+		{Name: "b", Age: 30, Score: &f2, Address: &specAddress{City: "Rome"}},
+		{Name: "a", Age: 30, Score: nil, Address: nil},
+		{Name: "c", Age: 20, Score: &f1, Address: &specAddress{City: "Oslo"}},
+		{Name: "d", Age: 40, Score: &f1, Address: &specAddress{City: "Oslo"}},
+	}
+	tests := []struct {
+		name string
+		spec string
+		want []string
+	}{
+		{name: "AgeDescNameAsc", spec: "Age desc, Name asc", want: []string{"d", "a", "b", "c"}},
+		{name: "DefaultAsc", spec: "Age,Name", want: []string{"c", "a", "b", "d"}},
+		{name: "CaseInsensitiveDirection", spec: "Name DESC", want: []string{"d", "c", "b", "a"}},
+		{name: "PointerField", spec: "Score, Name desc", want: []string{"a", "d", "c", "b"}},
+		{name: "NestedField", spec: "Address.City descending, Age", want: []string{"b", "c", "d", "a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmp := ComparerFromSpecMust[specPerson](tt.spec)
+			got := SelectMust(
+				OrderByCmpMust(NewOnSliceEn(pp...), Identity[specPerson], cmp).GetEnumerator(),
+				func(p specPerson) string { return p.Name },
+			)
+			want := NewOnSliceEn(tt.want...)
+			if !SequenceEqualMust(got, want) {
+				got.Reset()
+				want.Reset()
+				t.Errorf("ComparerFromSpec() = '%v', want '%v'", String(got), String(want))
+			}
+		})
+	}
+}
+
+func Test_ComparerFromSpec_pointer(t *testing.T) {
+	cmp := ComparerFromSpecMust[*specPerson]("Name")
+	pp := []*specPerson{{Name: "b"}, nil, {Name: "a"}}
+	got := SelectMust(
+		OrderByCmpMust(NewOnSliceEn(pp...), Identity[*specPerson], cmp).GetEnumerator(),
+		func(p *specPerson) string {
+			if p == nil {
+				return "<nil>"
+			}
+			return p.Name
+		},
+	)
+	want := NewOnSliceEn("<nil>", "a", "b")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("ComparerFromSpec() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_ComparerFromSpec_errors(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+	}{
+		{name: "Empty", spec: ""},
+		{name: "EmptyKey", spec: "Age,,Name"},
+		{name: "UnknownField", spec: "Height"},
+		{name: "UnknownDirection", spec: "Age up"},
+		{name: "TooManyTokens", spec: "Age asc desc"},
+		{name: "UnsupportedType", spec: "Address"},
+		{name: "NotStruct", spec: "Name.Length"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ComparerFromSpec[specPerson](tt.spec); !errors.Is(err, ErrInvalidSpec) {
+				t.Errorf("ComparerFromSpec() error = '%v', want '%v'", err, ErrInvalidSpec)
+			}
+		})
+	}
+	if _, err := ComparerFromSpec[int]("Age"); !errors.Is(err, ErrInvalidSpec) {
+		t.Errorf("ComparerFromSpec() error = '%v', want '%v'", err, ErrInvalidSpec)
+	}
+}
+
+func Test_ComposedComparer_operators(t *testing.T) {
+	pp := []specPerson{{Name: "b", Age: 1}, {Name: "A", Age: 2}, {Name: "a", Age: 3}, {Name: "B", Age: 4}}
+	byNameCI := ComparerByMust(func(p specPerson) string { return p.Name }, CaseInsensitiveComparer)
+	got := SelectMust(
+		DistinctCmpMust(NewOnSliceEn(pp...), byNameCI),
+		func(p specPerson) int { return p.Age },
+	)
+	want := NewOnSliceEn(1, 2)
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("DistinctCmp() = '%v', want '%v'", String(got), String(want))
+	}
+	byAgeDesc := ComparerByMust(func(p specPerson) int { return p.Age }, Order[int]{}).Reverse()
+	el := MinElMust(NewOnSliceEn(pp...), Identity[specPerson], Lesser[specPerson](byAgeDesc))
+	if el.Age != 4 {
+		t.Errorf("MinEl() = '%v', want '%v'", el.Age, 4)
+	}
+}
@@ -18,6 +18,9 @@ func Concat[Source any](first, second Enumerator[Source]) (Enumerator[Source], e
 				if from1 && first.MoveNext() {
 					return true
 				}
+				if Err(first) != nil {
+					return false
+				}
 				from1 = false
 				return second.MoveNext()
 			},
@@ -34,6 +37,8 @@ func Concat[Source any](first, second Enumerator[Source]) (Enumerator[Source], e
 					second.Reset()
 				}
 			},
+			err: func() error { return firstErr(Err(first), Err(second)) },
+			cls: func() error { return firstErr(Close(first), Close(second)) },
 		},
 		nil
 }
@@ -55,6 +60,9 @@ func ConcatSelf[Source any](first, second Enumerator[Source]) (Enumerator[Source
 		return nil, ErrNilSource
 	}
 	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
 	first.Reset()
 	return Concat(first, NewOnSliceEn(sl2...))
 }
@@ -34,9 +34,13 @@ func ContainsEq[Source any](source Enumerator[Source], value Source, equaler Equ
 	}
 	for source.MoveNext() {
 		if equaler.Equal(value, source.Current()) {
+			Close(source)
 			return true, nil
 		}
 	}
+	if err := Err(source); err != nil {
+		return false, err
+	}
 	return false, nil
 }
 
@@ -20,6 +20,9 @@ func Count[Source any](source Enumerator[Source]) (int, error) {
 	for source.MoveNext() {
 		r++
 	}
+	if err := Err(source); err != nil {
+		return -1, err
+	}
 	return r, nil
 }
 
@@ -46,6 +49,9 @@ func CountPred[Source any](source Enumerator[Source], predicate func(Source) boo
 			r++
 		}
 	}
+	if err := Err(source); err != nil {
+		return -1, err
+	}
 	return r, nil
 }
 
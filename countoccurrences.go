@@ -0,0 +1,127 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"sync"
+)
+
+// countOccurrences returns the distinct elements of 'source' with their numbers of occurrences.
+// 'source' is enumerated on the first MoveNext.
+func countOccurrences[Source any](source Enumerator[Source], newLookup func() *Lookup[Source, int]) Enumerator[KeyElement[Source, int]] {
+	var once sync.Once
+	var kk []KeyElement[Source, int]
+	i := -1
+	return OnFunc[KeyElement[Source, int]]{
+		mvNxt: func() bool {
+			once.Do(func() {
+				bc := &bagCounter[Source]{lk: newLookup()}
+				for source.MoveNext() {
+					bc.add(source.Current())
+				}
+				kk = make([]KeyElement[Source, int], len(bc.counts))
+				for j, gr := range bc.lk.grgr {
+					kk[j] = KeyElement[Source, int]{key: gr.key, element: bc.counts[j]}
+				}
+			})
+			if Err(source) != nil || i+1 >= len(kk) {
+				return false
+			}
+			i++
+			return true
+		},
+		crrnt: func() KeyElement[Source, int] {
+			if i < 0 || i >= len(kk) {
+				return KeyElement[Source, int]{}
+			}
+			return kk[i]
+		},
+		rst: func() { i = -1 },
+		err: func() error { return Err(source) },
+		cls: func() error { return Close(source) },
+	}
+}
+
+// CountOccurrences returns the distinct elements of a sequence with their numbers of occurrences
+// (the KeyElement's key is the element, the KeyElement's element is the number of its occurrences)
+// using reflect.DeepEqual to compare values.
+// Order of elements in the result corresponds to the order of their first occurrences in 'source'.
+// 'source' is enumerated on the first call to MoveNext.
+func CountOccurrences[Source any](source Enumerator[Source]) (Enumerator[KeyElement[Source, int]], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	return countOccurrences(source, lookupEqFactory[Source](nil)), nil
+}
+
+// CountOccurrencesMust is like CountOccurrences but panics in case of error.
+func CountOccurrencesMust[Source any](source Enumerator[Source]) Enumerator[KeyElement[Source, int]] {
+	r, err := CountOccurrences(source)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// CountOccurrencesEq returns the distinct elements of a sequence with their numbers of occurrences
+// using the specified Equaler to compare values. If 'equaler' is nil reflect.DeepEqual is used.
+// (See CountOccurrences function.)
+func CountOccurrencesEq[Source any](source Enumerator[Source], equaler Equaler[Source]) (Enumerator[KeyElement[Source, int]], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	return countOccurrences(source, lookupEqFactory(equaler)), nil
+}
+
+// CountOccurrencesEqMust is like CountOccurrencesEq but panics in case of error.
+func CountOccurrencesEqMust[Source any](source Enumerator[Source], equaler Equaler[Source]) Enumerator[KeyElement[Source, int]] {
+	r, err := CountOccurrencesEq(source, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// CountOccurrencesCmp returns the distinct elements of a sequence with their numbers of occurrences
+// using the specified Comparer to compare values.
+// (See CountOccurrences function.)
+func CountOccurrencesCmp[Source any](source Enumerator[Source], comparer Comparer[Source]) (Enumerator[KeyElement[Source, int]], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return countOccurrences(source, lookupCmpFactory(comparer)), nil
+}
+
+// CountOccurrencesCmpMust is like CountOccurrencesCmp but panics in case of error.
+func CountOccurrencesCmpMust[Source any](source Enumerator[Source], comparer Comparer[Source]) Enumerator[KeyElement[Source, int]] {
+	r, err := CountOccurrencesCmp(source, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// CountOccurrencesHash returns the distinct elements of a sequence with their numbers of occurrences
+// using the specified Hasher to compare values.
+// (See CountOccurrences function.)
+func CountOccurrencesHash[Source any](source Enumerator[Source], hasher Hasher[Source]) (Enumerator[KeyElement[Source, int]], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return countOccurrences(source, lookupHashFactory(hasher)), nil
+}
+
+// CountOccurrencesHashMust is like CountOccurrencesHash but panics in case of error.
+func CountOccurrencesHashMust[Source any](source Enumerator[Source], hasher Hasher[Source]) Enumerator[KeyElement[Source, int]] {
+	r, err := CountOccurrencesHash(source, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,69 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_CountOccurrencesMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		source Enumerator[string]
+		want   Enumerator[KeyElement[string, int]]
+	}{
+		{name: "EmptySource", source: Empty[string](), want: Empty[KeyElement[string, int]]()},
+		{name: "Simple",
+			source: NewOnSlice("b", "a", "b", "c", "b", "a"),
+			want: NewOnSlice(
+				KeyElement[string, int]{key: "b", element: 3},
+				KeyElement[string, int]{key: "a", element: 2},
+				KeyElement[string, int]{key: "c", element: 1},
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CountOccurrencesMust(tt.source)
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("CountOccurrences() = '%v', want '%v'", String(got), String(tt.want))
+			}
+			got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(got, tt.want) {
+				t.Errorf("CountOccurrences() after Reset differs")
+			}
+		})
+	}
+}
+
+func Test_CountOccurrences_variants(t *testing.T) {
+	source := []string{"a", "B", "b", "A", "a"}
+	want := NewOnSliceEn(
+		KeyElement[string, int]{key: "a", element: 3},
+		KeyElement[string, int]{key: "B", element: 2},
+	)
+	tests := []struct {
+		name string
+		got  Enumerator[KeyElement[string, int]]
+	}{
+		{name: "Eq", got: CountOccurrencesEqMust(NewOnSliceEn(source...), CaseInsensitiveEqualer)},
+		{name: "Cmp", got: CountOccurrencesCmpMust(NewOnSliceEn(source...), CaseInsensitiveComparer)},
+		{name: "Hash", got: CountOccurrencesHashMust(NewOnSliceEn(source...), CaseInsensitiveHasher)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want.Reset()
+			if !SequenceEqualMust(tt.got, want) {
+				tt.got.Reset()
+				want.Reset()
+				t.Errorf("CountOccurrences%s() = '%v', want '%v'", tt.name, String(tt.got), String(want))
+			}
+		})
+	}
+	if _, err := CountOccurrencesHash(NewOnSliceEn(1), nil); err != ErrNilHasher {
+		t.Errorf("CountOccurrencesHash() error = '%v', want '%v'", err, ErrNilHasher)
+	}
+}
@@ -0,0 +1,53 @@
+//go:build go1.18
+
+package go2linq
+
+// https://hackage.haskell.org/package/base/docs/Prelude.html#v:cycle
+
+// Cycle repeats the elements of a sequence infinitely: when 'source' ends, it is reset and enumerated again.
+// 'source' must have real Reset method.
+// If 'source' is empty, the result is empty (rather than looping forever),
+// if 'source' fails, the enumeration stops and the error is returned by the Err method of the resulting Enumerator.
+// Use Take or TakeWhile to limit the sequence.
+func Cycle[Source any](source Enumerator[Source]) (Enumerator[Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	// yielded - 'source' has returned an element during the current pass
+	var yielded bool
+	return OnFunc[Source]{
+			mvNxt: func() bool {
+				if source.MoveNext() {
+					yielded = true
+					return true
+				}
+				if !yielded || Err(source) != nil {
+					return false
+				}
+				yielded = false
+				source.Reset()
+				if source.MoveNext() {
+					yielded = true
+					return true
+				}
+				return false
+			},
+			crrnt: func() Source { return source.Current() },
+			rst: func() {
+				yielded = false
+				source.Reset()
+			},
+			err: func() error { return Err(source) },
+			cls: func() error { return Close(source) },
+		},
+		nil
+}
+
+// CycleMust is like Cycle but panics in case of error.
+func CycleMust[Source any](source Enumerator[Source]) Enumerator[Source] {
+	r, err := Cycle(source)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
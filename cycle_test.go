@@ -0,0 +1,44 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_CycleMust(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Enumerator[int]
+		want Enumerator[int]
+	}{
+		{name: "EmptySource", got: CycleMust(Empty[int]()), want: Empty[int]()},
+		{name: "SingleElement", got: TakeMust(CycleMust(NewOnSliceEn(7)), 3), want: NewOnSlice(7, 7, 7)},
+		{name: "Cycle", got: TakeMust(CycleMust(NewOnSliceEn(1, 2, 3)), 8), want: NewOnSlice(1, 2, 3, 1, 2, 3, 1, 2)},
+		{name: "CycleTakeWhile",
+			got:  TakeWhileMust(CycleMust(NewOnSliceEn(1, 2, 3, 4)), func(x int) bool { return x < 3 }),
+			want: NewOnSlice(1, 2),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !SequenceEqualMust(tt.got, tt.want) {
+				tt.got.Reset()
+				tt.want.Reset()
+				t.Errorf("Cycle() = '%v', want '%v'", String(tt.got), String(tt.want))
+			}
+			tt.got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(tt.got, tt.want) {
+				t.Errorf("Cycle() after Reset differs")
+			}
+		})
+	}
+}
+
+func Test_Cycle_error(t *testing.T) {
+	got := TakeMust(CycleMust[int](failAt(NewOnSliceEn(1, 2, 3), 3)), 10)
+	if _, err := SliceErr(got); err != errTest {
+		t.Errorf("Cycle() error = '%v', want '%v'", err, errTest)
+	}
+}
@@ -37,6 +37,9 @@ func DefaultIfEmptyDef[Source any](source Enumerator[Source], defaultValue Sourc
 				if first {
 					first = false
 					if !source.MoveNext() {
+						if Err(source) != nil {
+							return false
+						}
 						empty = true
 					}
 					return true
@@ -53,6 +56,8 @@ func DefaultIfEmptyDef[Source any](source Enumerator[Source], defaultValue Sourc
 				return source.Current()
 			},
 			rst: func() { first = true; empty = false; source.Reset() },
+			err: func() error { return Err(source) },
+			cls: func() error { return Close(source) },
 		},
 		nil
 }
@@ -55,3 +55,20 @@ func DistinctCmpMust[Source any](source Enumerator[Source], comparer Comparer[So
 	}
 	return r
 }
+
+// DistinctHash returns distinct elements from a sequence using a specified Hasher to compare values.
+//
+// Hash set of already seen elements is internally built.
+// Hash set allows to determine whether the element was seen or not in expected constant time.
+func DistinctHash[Source any](source Enumerator[Source], hasher Hasher[Source]) (Enumerator[Source], error) {
+	return DistinctByHash(source, Identity[Source], hasher)
+}
+
+// DistinctHashMust is like DistinctHash but panics in case of error.
+func DistinctHashMust[Source any](source Enumerator[Source], hasher Hasher[Source]) Enumerator[Source] {
+	r, err := DistinctHash(source, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
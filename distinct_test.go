@@ -3,6 +3,7 @@
 package go2linq
 
 import (
+	"math"
 	"testing"
 )
 
@@ -253,3 +254,99 @@ func Test_DistinctEq_Reset(t *testing.T) {
 		t.Errorf("Reset error: '%v' != '%v'", String(got1), String(got2))
 	}
 }
+
+func Test_DistinctHash_string(t *testing.T) {
+	type args struct {
+		source Enumerator[string]
+		hasher Hasher[string]
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        Enumerator[string]
+		wantErr     bool
+		expectedErr error
+	}{
+		{name: "NullSourceWithHasher",
+			args: args{
+				hasher: CaseInsensitiveHasher,
+			},
+			wantErr:     true,
+			expectedErr: ErrNilSource,
+		},
+		{name: "NullHasher",
+			args: args{
+				source: NewOnSlice("xyz", testString1, "XYZ", testString2, "def"),
+			},
+			wantErr:     true,
+			expectedErr: ErrNilHasher,
+		},
+		{name: "DistinctStringsWithOrderHasher",
+			args: args{
+				source: NewOnSlice("xyz", testString1, "XYZ", testString2, "def"),
+				hasher: Order[string]{},
+			},
+			want: NewOnSlice("xyz", testString1, "XYZ", "def"),
+		},
+		{name: "DistinctStringsWithCaseInsensitiveHasher",
+			args: args{
+				source: NewOnSlice("xyz", testString1, "XYZ", testString2, "def"),
+				hasher: CaseInsensitiveHasher,
+			},
+			want: NewOnSlice("xyz", testString1, "def"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DistinctHash(tt.args.source, tt.args.hasher)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DistinctHash() error = '%v', wantErr '%v'", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if err != tt.expectedErr {
+					t.Errorf("DistinctHash() error = '%v', expectedErr '%v'", err, tt.expectedErr)
+				}
+				return
+			}
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("DistinctHash() = '%v', want '%v'", String(got), String(tt.want))
+			}
+		})
+	}
+}
+
+func Test_DistinctHash_float64(t *testing.T) {
+	got := DistinctHashMust(NewOnSliceEn(0.0, math.Copysign(0, -1), 1.5, 1.5, -2.0), Order[float64]{})
+	want := NewOnSliceEn(0.0, 1.5, -2.0)
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("DistinctHash() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_DistinctHash_Million(t *testing.T) {
+	const n = 1000000
+	source := SelectMust(RangeMust(0, 2*n), func(i int) int { return i % n })
+	got := CountMust(DistinctHashMust(source, Order[int]{}))
+	if got != n {
+		t.Errorf("CountMust(DistinctHash()) = '%v', want '%v'", got, n)
+	}
+}
+
+func Test_DistinctHash_Reset(t *testing.T) {
+	source, _ := DistinctHash(
+		NewOnSliceEn("xyz", testString1, "XYZ", testString2, "def"),
+		CaseInsensitiveHasher)
+	got1 := NewOnSliceEn(Slice(source)...)
+	source.Reset()
+	got2 := NewOnSliceEn(Slice(source)...)
+	if !SequenceEqualMust(got1, got2) {
+		got1.Reset()
+		got2.Reset()
+		t.Errorf("Reset error: '%v' != '%v'", String(got1), String(got2))
+	}
+}
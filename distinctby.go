@@ -53,6 +53,8 @@ func DistinctByEq[Source, Key any](source Enumerator[Source], keySelector func(S
 			},
 			crrnt: func() Source { return c },
 			rst:   func() { seen = make([]Key, 0); source.Reset() },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
 		},
 		nil
 }
@@ -95,6 +97,8 @@ func DistinctByCmp[Source, Key any](source Enumerator[Source], keySelector func(
 			},
 			crrnt: func() Source { return c },
 			rst:   func() { seen = make([]Key, 0); source.Reset() },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
 		},
 		nil
 }
@@ -107,3 +111,44 @@ func DistinctByCmpMust[Source, Key any](source Enumerator[Source], keySelector f
 	}
 	return r
 }
+
+// DistinctByHash returns distinct elements from a sequence according to a specified key selector function
+// and using a specified Hasher to compare keys. (See DistinctHash function.)
+func DistinctByHash[Source, Key any](source Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) (Enumerator[Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	var c Source
+	seen := newHashSet(hasher)
+	return OnFunc[Source]{
+			mvNxt: func() bool {
+				for source.MoveNext() {
+					c = source.Current()
+					if seen.add(keySelector(c)) {
+						return true
+					}
+				}
+				return false
+			},
+			crrnt: func() Source { return c },
+			rst:   func() { seen = newHashSet(hasher); source.Reset() },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
+		},
+		nil
+}
+
+// DistinctByHashMust is like DistinctByHash but panics in case of error.
+func DistinctByHashMust[Source, Key any](source Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) Enumerator[Source] {
+	r, err := DistinctByHash(source, keySelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -153,3 +153,35 @@ func Test_DistinctByCmpMust_string_rune(t *testing.T) {
 		})
 	}
 }
+
+func Test_DistinctByHashMust_string_rune(t *testing.T) {
+	type args struct {
+		source      Enumerator[string]
+		keySelector func(string) rune
+		hasher      Hasher[rune]
+	}
+	tests := []struct {
+		name string
+		args args
+		want Enumerator[string]
+	}{
+		{name: "1",
+			args: args{
+				source:      NewOnSlice("one", "two", "three", "four", "five"),
+				keySelector: func(s string) rune { return []rune(s)[0] },
+				hasher:      Hasher[rune](Order[rune]{}),
+			},
+			want: NewOnSlice("one", "two", "four"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DistinctByHashMust(tt.args.source, tt.args.keySelector, tt.args.hasher)
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("DistinctByHashMust() = '%v', want '%v'", String(got), String(tt.want))
+			}
+		})
+	}
+}
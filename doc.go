@@ -21,4 +21,18 @@
 // So if you need to use Enumerators based on the same instance
 // (such as performing operations on adjacent elements (see Test_ZipSelf/AdjacentElements)),
 // use corresponding …Self… counterpart methods instead.
+//
+// Enumerators that may fail during iteration implement ErrEnumerator
+// (see SelectErr, WhereErr, SelectManyErr).
+// Operators propagate the first error encountered by their sources:
+// the enumeration stops and the error is returned by the Err method of the resulting Enumerator
+// and by the terminal operation (Count…, First…, SliceErr, ToMap…, etc.).
+//
+// With Go 1.23 or later, Enumerators are converted to and from range-over-func iterators
+// with FromSeq…/ToSeq… functions (see also WhereSeq, SelectSeq, OrderByLsSeq).
+//
+// Observable and Observer are the push-based counterparts of Enumerator
+// (https://docs.microsoft.com/dotnet/api/system.iobservable-1).
+// Observable operators have the …Obs suffix (WhereObs, SelectObs, ThrottleObs, etc.),
+// the time-based ones use a Clock (ManualClock allows to test them deterministically).
 package go2linq
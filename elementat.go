@@ -15,6 +15,9 @@ func ElementAt[Source any](source Enumerator[Source], index int) (Source, error)
 	if index < 0 {
 		return ZeroValue[Source](), ErrIndexOutOfRange
 	}
+	if op, ok := source.(orderedPartition[Source]); ok && op.fresh() {
+		return op.elementAt(index)
+	}
 	if counter, ok := source.(Counter); ok {
 		if index >= counter.Count() {
 			return ZeroValue[Source](), ErrIndexOutOfRange
@@ -26,10 +29,15 @@ func ElementAt[Source any](source Enumerator[Source], index int) (Source, error)
 	i := 0
 	for source.MoveNext() {
 		if i == index {
-			return source.Current(), nil
+			r := source.Current()
+			Close(source)
+			return r, nil
 		}
 		i++
 	}
+	if err := Err(source); err != nil {
+		return ZeroValue[Source](), err
+	}
 	return ZeroValue[Source](), ErrIndexOutOfRange
 }
 
@@ -51,6 +59,9 @@ func ElementAtOrDefault[Source any](source Enumerator[Source], index int) (Sourc
 	if err == ErrIndexOutOfRange {
 		return ZeroValue[Source](), nil
 	}
+	if err != nil {
+		return ZeroValue[Source](), err
+	}
 	return r, nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"golang.org/x/sync/errgroup"
+	"io"
 	"reflect"
 	"strings"
 )
@@ -27,6 +28,41 @@ type Enumerator[T any] interface {
 	Reset()
 }
 
+// ErrEnumerator is an Enumerator that may fail during iteration
+// (in the style of bufio.Scanner).
+//
+// When an error occurs, MoveNext returns false and Err returns the error.
+type ErrEnumerator[T any] interface {
+	Enumerator[T]
+
+	// Err returns the first error that was encountered by the enumerator (nil if there was no error).
+	Err() error
+}
+
+// Err returns the error encountered by 'en' during iteration.
+// Err returns nil if 'en' is not an ErrEnumerator or if no error was encountered.
+func Err[T any](en Enumerator[T]) error {
+	if errEn, ok := en.(ErrEnumerator[T]); ok {
+		return errEn.Err()
+	}
+	return nil
+}
+
+// Close releases the resources held by 'en' if 'en' implements io.Closer
+// (https://docs.microsoft.com/dotnet/api/system.idisposable).
+// Close returns nil if 'en' does not implement io.Closer.
+//
+// Enumerators returned by the package's operators implement io.Closer and close their sources.
+// Operators that stop enumerating their sources early (Take, TakeWhile, First, Zip, etc.)
+// close the sources themselves.
+// So Close may be called more than once, io.Closer implementations used as sources must tolerate this.
+func Close[T any](en Enumerator[T]) error {
+	if closer, ok := en.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
 // Slice creates a slice from an Enumerator.
 // Slice returns nil if 'en' is nil.
 func Slice[T any](en Enumerator[T]) []T {
@@ -45,6 +81,8 @@ func Slice[T any](en Enumerator[T]) []T {
 
 // SliceErr is like Slice but:
 //
+// - if 'en' is an ErrEnumerator which encountered an error, the error is returned;
+//
 // - if the underlying Slice panics with an error, the error is recovered and returned;
 //
 // - if the underlying Slice panics with a string, the string is recovered and error containing the string is returned.
@@ -52,7 +90,11 @@ func SliceErr[T any](en Enumerator[T]) (res []T, err error) {
 	defer func() {
 		catchErrStr[[]T](recover(), &res, &err)
 	}()
-	return Slice[T](en), nil
+	r := Slice[T](en)
+	if err := Err(en); err != nil {
+		return nil, err
+	}
+	return r, nil
 }
 
 func asStringPrim[T any](t T, isStringer bool) string {
@@ -100,6 +142,8 @@ func ToStrings[T any](en Enumerator[T]) Enumerator[string] {
 		mvNxt: func() bool { return en.MoveNext() },
 		crrnt: func() string { return asStringPrim(en.Current(), isStringer) },
 		rst:   func() { en.Reset() },
+		err:   func() error { return Err(en) },
+		cls:   func() error { return Close(en) },
 	}
 }
 
@@ -134,18 +178,22 @@ func ForEach[T any](ctx context.Context, en Enumerator[T], action func(context.C
 	for en.MoveNext() {
 		select {
 		case <-ctx.Done():
+			Close(en)
 			return ctx.Err()
 		default:
 			if err := action(ctx, en.Current()); err != nil {
+				Close(en)
 				return err
 			}
 		}
 	}
-	return nil
+	return Err(en)
 }
 
 // ForEachConcurrent concurrently performs the specified action on each element of the sequence starting from the current.
 // 'ctx' may be used to cancel the operation in progress.
+// The first error returned by 'action' cancels the context passed to the other actions.
+// (See ForEachConcurrentOpts for bounded concurrency and other options.)
 func ForEachConcurrent[T any](ctx context.Context, en Enumerator[T], action func(context.Context, T) error) error {
 	if en == nil {
 		return ErrNilSource
@@ -153,20 +201,23 @@ func ForEachConcurrent[T any](ctx context.Context, en Enumerator[T], action func
 	if action == nil {
 		return ErrNilAction
 	}
-	g := new(errgroup.Group)
+	g, gctx := errgroup.WithContext(ctx)
 	for en.MoveNext() {
 		c := en.Current()
 		g.Go(func() error {
 			select {
-			case <-ctx.Done():
-				return ctx.Err()
+			case <-gctx.Done():
+				return gctx.Err()
 			default:
-				if err := action(ctx, c); err != nil {
+				if err := action(gctx, c); err != nil {
 					return err
 				}
 			}
 			return nil
 		})
 	}
-	return g.Wait()
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	return Err(en)
 }
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync/atomic"
 	"testing"
 )
@@ -361,3 +362,76 @@ func TestForEachConcurrent_int(t *testing.T) {
 		})
 	}
 }
+
+func TestClose_operators(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(cc1, cc2 *closeCounter)
+	}{
+		{name: "TakeClosesSourceWhenCountReached",
+			run: func(cc1, _ *closeCounter) {
+				en := TakeMust[int](cc1, 1)
+				for en.MoveNext() {
+				}
+			},
+		},
+		{name: "TakeWhileClosesSourceWhenPredicateFails",
+			run: func(cc1, _ *closeCounter) {
+				en := TakeWhileMust[int](cc1, func(i int) bool { return i < 2 })
+				for en.MoveNext() {
+				}
+			},
+		},
+		{name: "FirstClosesSource",
+			run: func(cc1, _ *closeCounter) {
+				FirstMust[int](cc1)
+			},
+		},
+		{name: "AnyClosesSource",
+			run: func(cc1, _ *closeCounter) {
+				AnyMust[int](cc1)
+			},
+		},
+		{name: "ZipClosesBothSources",
+			run: func(cc1, cc2 *closeCounter) {
+				en := ZipMust[int, int, int](cc1, cc2, func(i1, i2 int) int { return i1 + i2 })
+				for en.MoveNext() {
+				}
+			},
+		},
+		{name: "ConcatClosesBothSources",
+			run: func(cc1, cc2 *closeCounter) {
+				en := ConcatMust[int](cc1, cc2)
+				en.MoveNext()
+				Close[int](en)
+			},
+		},
+		{name: "SelectManyClosesSource",
+			run: func(cc1, cc2 *closeCounter) {
+				en := SelectManyMust[int, int](cc1, func(int) Enumerator[int] { return cc2 })
+				en.MoveNext()
+				Close[int](en)
+			},
+		},
+		{name: "JoinClosesBothSources",
+			run: func(cc1, cc2 *closeCounter) {
+				en := JoinMust[int, int, int, int](cc1, cc2, Identity[int], Identity[int],
+					func(i1, i2 int) int { return i1 * i2 })
+				en.MoveNext()
+				Close[int](en)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cc1, cc2 := newCloseCounter(1, 2, 3), newCloseCounter(1, 2, 3)
+			tt.run(cc1, cc2)
+			if cc1.closed == 0 {
+				t.Errorf("Close() was not called on the first source")
+			}
+			if strings.Contains(tt.name, "Both") && cc2.closed == 0 {
+				t.Errorf("Close() was not called on the second source")
+			}
+		})
+	}
+}
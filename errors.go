@@ -7,19 +7,30 @@ import (
 )
 
 var (
-	ErrDuplicateKeys    = errors.New("duplicate keys")
-	ErrEmptySource      = errors.New("empty source")
-	ErrIndexOutOfRange  = errors.New("index out of range")
-	ErrMultipleElements = errors.New("multiple elements")
-	ErrMultipleMatch    = errors.New("multiple match")
-	ErrNegativeCount    = errors.New("negative count")
-	ErrNilAccumulator   = errors.New("nil accumulator")
-	ErrNilAction        = errors.New("nil action")
-	ErrNilComparer      = errors.New("nil comparer")
-	ErrNilLesser        = errors.New("nil lesser")
-	ErrNilPredicate     = errors.New("nil predicate")
-	ErrNilSelector      = errors.New("nil selector")
-	ErrNilSource        = errors.New("nil source")
-	ErrNoMatch          = errors.New("no match")
-	ErrSizeOutOfRange   = errors.New("size out of range")
+	ErrCycle                = errors.New("cycle detected")
+	ErrDuplicateKeys        = errors.New("duplicate keys")
+	ErrDurationOutOfRange   = errors.New("duration out of range")
+	ErrEmptySource          = errors.New("empty source")
+	ErrIndexOutOfRange      = errors.New("index out of range")
+	ErrInvalidInterpolation = errors.New("invalid interpolation")
+	ErrInvalidSpec          = errors.New("invalid spec")
+	ErrMultipleElements     = errors.New("multiple elements")
+	ErrMultipleMatch        = errors.New("multiple match")
+	ErrNegativeCount        = errors.New("negative count")
+	ErrNilAccumulator       = errors.New("nil accumulator")
+	ErrNilAction            = errors.New("nil action")
+	ErrNilCombiner          = errors.New("nil combiner")
+	ErrNilComparer          = errors.New("nil comparer")
+	ErrNilHasher            = errors.New("nil hasher")
+	ErrNilLesser            = errors.New("nil lesser")
+	ErrNilPredicate         = errors.New("nil predicate")
+	ErrNilSelector          = errors.New("nil selector")
+	ErrNilSource            = errors.New("nil source")
+	ErrNoMatch              = errors.New("no match")
+	ErrNotFinite            = errors.New("not finite value")
+	ErrNotOrdered           = errors.New("not ordered")
+	ErrNotSorted            = errors.New("not sorted")
+	ErrOffsetOutOfRange     = errors.New("offset out of range")
+	ErrQuantileOutOfRange   = errors.New("quantile out of range")
+	ErrSizeOutOfRange       = errors.New("size out of range")
 )
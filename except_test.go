@@ -259,3 +259,63 @@ func Test_ExceptCmpSelf_int(t *testing.T) {
 		})
 	}
 }
+
+func Test_ExceptHash_int(t *testing.T) {
+	type args struct {
+		first  Enumerator[int]
+		second Enumerator[int]
+		hasher Hasher[int]
+	}
+	tests := []struct {
+		name string
+		args args
+		want Enumerator[int]
+	}{
+		{name: "IntHasherSpecified",
+			args: args{
+				first:  NewOnSlice(1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8),
+				second: NewOnSlice(4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10),
+				hasher: Order[int]{},
+			},
+			want: NewOnSlice(1, 2, 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := ExceptHash(tt.args.first, tt.args.second, tt.args.hasher); !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("ExceptHash() = '%v', want '%v'", String(got), String(tt.want))
+			}
+		})
+	}
+}
+
+func Test_ExceptHash_string(t *testing.T) {
+	type args struct {
+		first  Enumerator[string]
+		second Enumerator[string]
+		hasher Hasher[string]
+	}
+	tests := []struct {
+		name string
+		args args
+		want Enumerator[string]
+	}{
+		{name: "CaseInsensitiveHasherSpecified",
+			args: args{
+				first:  NewOnSlice("A", "a", "b", "c", "b"),
+				second: NewOnSlice("b", "a", "d", "a"),
+				hasher: CaseInsensitiveHasher,
+			},
+			want: NewOnSlice("c")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := ExceptHash(tt.args.first, tt.args.second, tt.args.hasher); !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("ExceptHash() = '%v', want '%v'", String(got), String(tt.want))
+			}
+		})
+	}
+}
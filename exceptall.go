@@ -0,0 +1,459 @@
+//go:build go1.18
+
+package go2linq
+
+// https://www.postgresql.org/docs/current/queries-union.html
+
+// ExceptAll produces the multiset difference of two sequences
+// using reflect.DeepEqual to compare values.
+// Each element of 'second' cancels one occurrence of an equal element of 'first'
+// (so if 'first' has three copies of an element and 'second' has one, two copies remain),
+// as SQL EXCEPT ALL does.
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use ExceptAllSelf instead.
+func ExceptAll[Source any](first, second Enumerator[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	return bagOp(first, second, Identity[Source], lookupEqFactory[Source](nil), bagExcept), nil
+}
+
+// ExceptAllMust is like ExceptAll but panics in case of error.
+func ExceptAllMust[Source any](first, second Enumerator[Source]) Enumerator[Source] {
+	r, err := ExceptAll(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllSelf produces the multiset difference of two sequences
+// using reflect.DeepEqual to compare values.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func ExceptAllSelf[Source any](first, second Enumerator[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return ExceptAll(first, NewOnSliceEn(sl2...))
+}
+
+// ExceptAllSelfMust is like ExceptAllSelf but panics in case of error.
+func ExceptAllSelfMust[Source any](first, second Enumerator[Source]) Enumerator[Source] {
+	r, err := ExceptAllSelf(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllEq produces the multiset difference of two sequences
+// using the specified Equaler to compare values.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use ExceptAllEqSelf instead.
+func ExceptAllEq[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	return bagOp(first, second, Identity[Source], lookupEqFactory(equaler), bagExcept), nil
+}
+
+// ExceptAllEqMust is like ExceptAllEq but panics in case of error.
+func ExceptAllEqMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) Enumerator[Source] {
+	r, err := ExceptAllEq(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllEqSelf produces the multiset difference of two sequences
+// using the specified Equaler to compare values.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func ExceptAllEqSelf[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return ExceptAllEq(first, NewOnSliceEn(sl2...), equaler)
+}
+
+// ExceptAllEqSelfMust is like ExceptAllEqSelf but panics in case of error.
+func ExceptAllEqSelfMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) Enumerator[Source] {
+	r, err := ExceptAllEqSelf(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllCmp produces the multiset difference of two sequences
+// using the specified Comparer to compare values.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use ExceptAllCmpSelf instead.
+func ExceptAllCmp[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return bagOp(first, second, Identity[Source], lookupCmpFactory(comparer), bagExcept), nil
+}
+
+// ExceptAllCmpMust is like ExceptAllCmp but panics in case of error.
+func ExceptAllCmpMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) Enumerator[Source] {
+	r, err := ExceptAllCmp(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllCmpSelf produces the multiset difference of two sequences
+// using the specified Comparer to compare values.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func ExceptAllCmpSelf[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return ExceptAllCmp(first, NewOnSliceEn(sl2...), comparer)
+}
+
+// ExceptAllCmpSelfMust is like ExceptAllCmpSelf but panics in case of error.
+func ExceptAllCmpSelfMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) Enumerator[Source] {
+	r, err := ExceptAllCmpSelf(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllHash produces the multiset difference of two sequences
+// using the specified Hasher to compare values.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use ExceptAllHashSelf instead.
+func ExceptAllHash[Source any](first, second Enumerator[Source], hasher Hasher[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return bagOp(first, second, Identity[Source], lookupHashFactory(hasher), bagExcept), nil
+}
+
+// ExceptAllHashMust is like ExceptAllHash but panics in case of error.
+func ExceptAllHashMust[Source any](first, second Enumerator[Source], hasher Hasher[Source]) Enumerator[Source] {
+	r, err := ExceptAllHash(first, second, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllHashSelf produces the multiset difference of two sequences
+// using the specified Hasher to compare values.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func ExceptAllHashSelf[Source any](first, second Enumerator[Source], hasher Hasher[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return ExceptAllHash(first, NewOnSliceEn(sl2...), hasher)
+}
+
+// ExceptAllHashSelfMust is like ExceptAllHashSelf but panics in case of error.
+func ExceptAllHashSelfMust[Source any](first, second Enumerator[Source], hasher Hasher[Source]) Enumerator[Source] {
+	r, err := ExceptAllHashSelf(first, second, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllBy produces the multiset difference of two sequences according to a specified key selector function
+// using reflect.DeepEqual to compare keys.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use ExceptAllBySelf instead.
+func ExceptAllBy[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	return bagOp(first, second, keySelector, lookupEqFactory[Key](nil), bagExcept), nil
+}
+
+// ExceptAllByMust is like ExceptAllBy but panics in case of error.
+func ExceptAllByMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) Enumerator[Source] {
+	r, err := ExceptAllBy(first, second, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllBySelf produces the multiset difference of two sequences according to a specified key selector function
+// using reflect.DeepEqual to compare keys.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func ExceptAllBySelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return ExceptAllBy(first, NewOnSliceEn(sl2...), keySelector)
+}
+
+// ExceptAllBySelfMust is like ExceptAllBySelf but panics in case of error.
+func ExceptAllBySelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) Enumerator[Source] {
+	r, err := ExceptAllBySelf(first, second, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllByEq produces the multiset difference of two sequences according to a specified key selector function
+// using the specified Equaler to compare keys.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use ExceptAllByEqSelf instead.
+func ExceptAllByEq[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	return bagOp(first, second, keySelector, lookupEqFactory(equaler), bagExcept), nil
+}
+
+// ExceptAllByEqMust is like ExceptAllByEq but panics in case of error.
+func ExceptAllByEqMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) Enumerator[Source] {
+	r, err := ExceptAllByEq(first, second, keySelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllByEqSelf produces the multiset difference of two sequences according to a specified key selector function
+// using the specified Equaler to compare keys.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func ExceptAllByEqSelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return ExceptAllByEq(first, NewOnSliceEn(sl2...), keySelector, equaler)
+}
+
+// ExceptAllByEqSelfMust is like ExceptAllByEqSelf but panics in case of error.
+func ExceptAllByEqSelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) Enumerator[Source] {
+	r, err := ExceptAllByEqSelf(first, second, keySelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllByCmp produces the multiset difference of two sequences according to a specified key selector function
+// using the specified Comparer to compare keys.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use ExceptAllByCmpSelf instead.
+func ExceptAllByCmp[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return bagOp(first, second, keySelector, lookupCmpFactory(comparer), bagExcept), nil
+}
+
+// ExceptAllByCmpMust is like ExceptAllByCmp but panics in case of error.
+func ExceptAllByCmpMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) Enumerator[Source] {
+	r, err := ExceptAllByCmp(first, second, keySelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllByCmpSelf produces the multiset difference of two sequences according to a specified key selector function
+// using the specified Comparer to compare keys.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func ExceptAllByCmpSelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return ExceptAllByCmp(first, NewOnSliceEn(sl2...), keySelector, comparer)
+}
+
+// ExceptAllByCmpSelfMust is like ExceptAllByCmpSelf but panics in case of error.
+func ExceptAllByCmpSelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) Enumerator[Source] {
+	r, err := ExceptAllByCmpSelf(first, second, keySelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllByHash produces the multiset difference of two sequences according to a specified key selector function
+// using the specified Hasher to compare keys.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use ExceptAllByHashSelf instead.
+func ExceptAllByHash[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return bagOp(first, second, keySelector, lookupHashFactory(hasher), bagExcept), nil
+}
+
+// ExceptAllByHashMust is like ExceptAllByHash but panics in case of error.
+func ExceptAllByHashMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) Enumerator[Source] {
+	r, err := ExceptAllByHash(first, second, keySelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExceptAllByHashSelf produces the multiset difference of two sequences according to a specified key selector function
+// using the specified Hasher to compare keys.
+// (See ExceptAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func ExceptAllByHashSelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return ExceptAllByHash(first, NewOnSliceEn(sl2...), keySelector, hasher)
+}
+
+// ExceptAllByHashSelfMust is like ExceptAllByHashSelf but panics in case of error.
+func ExceptAllByHashSelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) Enumerator[Source] {
+	r, err := ExceptAllByHashSelf(first, second, keySelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
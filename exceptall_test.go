@@ -0,0 +1,87 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_ExceptAllMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  Enumerator[int]
+		second Enumerator[int]
+		want   Enumerator[int]
+	}{
+		{name: "EmptySecond", first: NewOnSlice(1, 1, 2), second: Empty[int](), want: NewOnSlice(1, 1, 2)},
+		{name: "EmptyFirst", first: Empty[int](), second: NewOnSlice(1), want: Empty[int]()},
+		{name: "Multiplicities", first: NewOnSlice(1, 2, 1, 3, 1), second: NewOnSlice(1, 3, 4), want: NewOnSlice(2, 1, 1)},
+		{name: "AllRemoved", first: NewOnSlice(1, 1), second: NewOnSlice(1, 1, 1), want: Empty[int]()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExceptAllMust(tt.first, tt.second)
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("ExceptAll() = '%v', want '%v'", String(got), String(tt.want))
+			}
+			got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(got, tt.want) {
+				t.Errorf("ExceptAll() after Reset differs")
+			}
+		})
+	}
+}
+
+func Test_ExceptAll_variants(t *testing.T) {
+	first := []string{"a", "B", "b", "A", "c"}
+	second := []string{"A", "b"}
+	want := NewOnSliceEn("b", "A", "c")
+	tests := []struct {
+		name string
+		got  Enumerator[string]
+	}{
+		{name: "Eq", got: ExceptAllEqMust(NewOnSliceEn(first...), NewOnSliceEn(second...), CaseInsensitiveEqualer)},
+		{name: "Cmp", got: ExceptAllCmpMust(NewOnSliceEn(first...), NewOnSliceEn(second...), CaseInsensitiveComparer)},
+		{name: "Hash", got: ExceptAllHashMust(NewOnSliceEn(first...), NewOnSliceEn(second...), CaseInsensitiveHasher)},
+		{name: "By", got: ExceptAllByMust(NewOnSliceEn(first...), NewOnSliceEn(second...), strings.ToLower)},
+		{name: "ByCmp", got: ExceptAllByCmpMust(NewOnSliceEn(first...), NewOnSliceEn(second...), strings.ToLower, Comparer[string](Order[string]{}))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want.Reset()
+			if !SequenceEqualMust(tt.got, want) {
+				tt.got.Reset()
+				want.Reset()
+				t.Errorf("ExceptAll%s() = '%v', want '%v'", tt.name, String(tt.got), String(want))
+			}
+		})
+	}
+}
+
+func Test_ExceptAllSelfMust(t *testing.T) {
+	e := NewOnSliceEn(1, 2, 2, 3)
+	got := ExceptAllSelfMust(e, SkipMust(e, 2))
+	want := NewOnSliceEn(1, 2)
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("ExceptAllSelf() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_ExceptAll_errors(t *testing.T) {
+	if _, err := ExceptAllCmp(NewOnSliceEn(1), NewOnSliceEn(1), nil); err != ErrNilComparer {
+		t.Errorf("ExceptAllCmp() error = '%v', want '%v'", err, ErrNilComparer)
+	}
+	if _, err := ExceptAllBy[int, int](NewOnSliceEn(1), NewOnSliceEn(1), nil); err != ErrNilSelector {
+		t.Errorf("ExceptAllBy() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+	got := ExceptAllMust(NewOnSliceEn(1, 2), failAt(NewOnSliceEn(1, 2, 3), 2))
+	if _, err := SliceErr(got); err != errTest {
+		t.Errorf("ExceptAll() error = '%v', want '%v'", err, errTest)
+	}
+}
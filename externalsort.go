@@ -0,0 +1,424 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/gob"
+	"io"
+	"os"
+	"sort"
+)
+
+// https://en.wikipedia.org/wiki/External_sorting
+
+// Codec encodes the elements to and decodes them from a stream.
+type Codec[T any] interface {
+	// NewEncoder returns a function that writes elements to 'w'.
+	NewEncoder(w io.Writer) func(T) error
+	// NewDecoder returns a function that reads the next element from 'r'.
+	// The function returns io.EOF when there are no more elements.
+	NewDecoder(r io.Reader) func() (T, error)
+}
+
+// GobCodec is a Codec based on encoding/gob.
+type GobCodec[T any] struct{}
+
+// NewEncoder implements the Codec.NewEncoder method.
+func (GobCodec[T]) NewEncoder(w io.Writer) func(T) error {
+	enc := gob.NewEncoder(w)
+	return func(el T) error { return enc.Encode(el) }
+}
+
+// NewDecoder implements the Codec.NewDecoder method.
+func (GobCodec[T]) NewDecoder(r io.Reader) func() (T, error) {
+	dec := gob.NewDecoder(r)
+	return func() (T, error) {
+		var el T
+		err := dec.Decode(&el)
+		return el, err
+	}
+}
+
+// defaultRunSize is the default ExternalSortOptions.RunSize
+const defaultRunSize = 1 << 16
+
+// defaultMaxOpenRuns is the default ExternalSortOptions.MaxOpenRuns
+const defaultMaxOpenRuns = 64
+
+// ExternalSortOptions configures the external sorting (see OrderByExternal).
+type ExternalSortOptions[T any] struct {
+	// RunSize is the maximum number of elements sorted in memory at once.
+	// 65536 is used if RunSize is not positive.
+	RunSize int
+	// Codec is used to write the sorted runs to the temporary files.
+	// GobCodec is used if Codec is nil.
+	Codec Codec[T]
+	// Dir is the directory for the temporary files. The default directory for temporary files is used if Dir is empty.
+	Dir string
+	// MaxOpenRuns is the maximum number of runs merged at once (i.e. the maximum number of temporary files open at the same time).
+	// If there are more runs, they are merged in several passes. 64 is used if MaxOpenRuns is less than 2.
+	MaxOpenRuns int
+}
+
+// OrderByExternal sorts the elements of a sequence in ascending order using a specified lesser,
+// the sequence does not need to fit in memory.
+//
+// The sequence is split into runs of opts.RunSize elements. Each run is sorted in memory
+// and written to a temporary file, then the runs are merged lazily during the enumeration.
+// If there are more than opts.MaxOpenRuns runs, they are first merged into fewer, longer runs,
+// so at most opts.MaxOpenRuns temporary files are open at the same time.
+// (If the whole sequence fits in a single run, no temporary files are created.)
+// The sorting is stable and may be refined by ThenBy….
+// The temporary files are deleted when the enumeration completes or the Enumerator is closed (see Close).
+// Reset deletes the temporary files and resets the source, so the next enumeration sorts the source anew.
+// The I/O errors are reported by Err (see ErrEnumerator).
+func OrderByExternal[Source, Key any](source Enumerator[Source], keySelector func(Source) Key,
+	lesser Lesser[Key], opts ExternalSortOptions[Source]) (*OrderedEnumerable[Source], error) {
+	oe, err := OrderByLs(source, keySelector, lesser)
+	if err != nil {
+		return nil, err
+	}
+	oe.ext = &opts
+	return oe, nil
+}
+
+// OrderByExternalMust is like OrderByExternal but panics in case of error.
+func OrderByExternalMust[Source, Key any](source Enumerator[Source], keySelector func(Source) Key,
+	lesser Lesser[Key], opts ExternalSortOptions[Source]) *OrderedEnumerable[Source] {
+	r, err := OrderByExternal(source, keySelector, lesser, opts)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// OrderByDescendingExternal sorts the elements of a sequence in descending order using a specified lesser,
+// the sequence does not need to fit in memory (see OrderByExternal).
+func OrderByDescendingExternal[Source, Key any](source Enumerator[Source], keySelector func(Source) Key,
+	lesser Lesser[Key], opts ExternalSortOptions[Source]) (*OrderedEnumerable[Source], error) {
+	oe, err := OrderByDescendingLs(source, keySelector, lesser)
+	if err != nil {
+		return nil, err
+	}
+	oe.ext = &opts
+	return oe, nil
+}
+
+// OrderByDescendingExternalMust is like OrderByDescendingExternal but panics in case of error.
+func OrderByDescendingExternalMust[Source, Key any](source Enumerator[Source], keySelector func(Source) Key,
+	lesser Lesser[Key], opts ExternalSortOptions[Source]) *OrderedEnumerable[Source] {
+	r, err := OrderByDescendingExternal(source, keySelector, lesser, opts)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// externalRun is a sorted run being merged
+type externalRun[T any] struct {
+	next func() (T, error)
+	head T
+	// idx - the run's index, the equal elements of the earlier runs go first
+	idx int
+}
+
+// runHeap is a min-heap of the runs ordered by their heads
+type runHeap[T any] struct {
+	ls   Lesser[T]
+	runs []*externalRun[T]
+}
+
+func (h *runHeap[T]) Len() int { return len(h.runs) }
+func (h *runHeap[T]) Less(i, j int) bool {
+	ri, rj := h.runs[i], h.runs[j]
+	if h.ls.Less(ri.head, rj.head) {
+		return true
+	}
+	if h.ls.Less(rj.head, ri.head) {
+		return false
+	}
+	return ri.idx < rj.idx
+}
+func (h *runHeap[T]) Swap(i, j int) { h.runs[i], h.runs[j] = h.runs[j], h.runs[i] }
+func (h *runHeap[T]) Push(x any)    { h.runs = append(h.runs, x.(*externalRun[T])) }
+func (h *runHeap[T]) Pop() any {
+	x := h.runs[len(h.runs)-1]
+	h.runs = h.runs[:len(h.runs)-1]
+	return x
+}
+
+// next removes and returns the least head of the runs, false is returned if all the runs are exhausted
+func (h *runHeap[T]) next() (T, bool, error) {
+	if h.Len() == 0 {
+		return ZeroValue[T](), false, nil
+	}
+	r := h.runs[0]
+	el := r.head
+	head, err := r.next()
+	switch {
+	case err == io.EOF:
+		heap.Pop(h)
+	case err != nil:
+		return ZeroValue[T](), false, err
+	default:
+		r.head = head
+		heap.Fix(h, 0)
+	}
+	return el, true, nil
+}
+
+// externalEnumerator is the Enumerator returned by OrderedEnumerable.GetEnumerator for the external sorting
+type externalEnumerator[T any] struct {
+	oe      *OrderedEnumerable[T]
+	started bool
+	// mem - the sorted sequence if it fits in a single run
+	mem []T
+	idx int
+	// runs - the names of the temporary files with the runs, open - the files being merged
+	runs  []string
+	open  []*os.File
+	h     *runHeap[T]
+	crrnt T
+	err   error
+}
+
+func (en *externalEnumerator[T]) codec() Codec[T] {
+	if en.oe.ext.Codec == nil {
+		return GobCodec[T]{}
+	}
+	return en.oe.ext.Codec
+}
+
+// writeRun writes the elements returned by 'next' (until it returns false) to a new temporary file
+// and returns the file's name
+func (en *externalEnumerator[T]) writeRun(next func() (T, bool, error)) (string, error) {
+	f, err := os.CreateTemp(en.oe.ext.Dir, "go2linq-*")
+	if err != nil {
+		return "", err
+	}
+	err = func() error {
+		bw := bufio.NewWriter(f)
+		encode := en.codec().NewEncoder(bw)
+		for {
+			el, ok, err := next()
+			if err != nil {
+				return err
+			}
+			if !ok {
+				break
+			}
+			if err := encode(el); err != nil {
+				return err
+			}
+		}
+		return bw.Flush()
+	}()
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// spill sorts 'run' and writes it to a temporary file
+func (en *externalEnumerator[T]) spill(run []T) error {
+	sort.SliceStable(run, func(i, j int) bool { return en.oe.ls.Less(run[i], run[j]) })
+	i := 0
+	name, err := en.writeRun(func() (T, bool, error) {
+		if i >= len(run) {
+			return ZeroValue[T](), false, nil
+		}
+		i++
+		return run[i-1], true, nil
+	})
+	if err != nil {
+		return err
+	}
+	en.runs = append(en.runs, name)
+	return nil
+}
+
+// openRuns opens the runs stored in the files 'names' and returns the heap of their heads
+// with the opened files (the files are returned even in case of error)
+func (en *externalEnumerator[T]) openRuns(names []string) (*runHeap[T], []*os.File, error) {
+	h := &runHeap[T]{ls: en.oe.ls}
+	var files []*os.File
+	for i, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, files, err
+		}
+		files = append(files, f)
+		r := &externalRun[T]{next: en.codec().NewDecoder(bufio.NewReader(f)), idx: i}
+		head, err := r.next()
+		if err == io.EOF {
+			continue
+		}
+		if err != nil {
+			return nil, files, err
+		}
+		r.head = head
+		h.runs = append(h.runs, r)
+	}
+	heap.Init(h)
+	return h, files, nil
+}
+
+// mergeRuns merges the runs stored in the files 'names' into a new run and deletes the files
+func (en *externalEnumerator[T]) mergeRuns(names []string) (string, error) {
+	h, files, err := en.openRuns(names)
+	if err == nil {
+		var name string
+		name, err = en.writeRun(h.next)
+		if err == nil {
+			for _, f := range files {
+				f.Close()
+			}
+			for _, name := range names {
+				os.Remove(name)
+			}
+			return name, nil
+		}
+	}
+	for _, f := range files {
+		f.Close()
+	}
+	return "", err
+}
+
+// start splits the source into the sorted runs and prepares the merging
+func (en *externalEnumerator[T]) start() error {
+	en.started = true
+	runSize := en.oe.ext.RunSize
+	if runSize <= 0 {
+		runSize = defaultRunSize
+	}
+	var run []T
+	for en.oe.en.MoveNext() {
+		run = append(run, en.oe.en.Current())
+		if len(run) == runSize {
+			if err := en.spill(run); err != nil {
+				return err
+			}
+			run = run[:0]
+		}
+	}
+	if err := Err(en.oe.en); err != nil {
+		return err
+	}
+	if len(en.runs) == 0 {
+		sort.SliceStable(run, func(i, j int) bool { return en.oe.ls.Less(run[i], run[j]) })
+		en.mem = run
+		return nil
+	}
+	if len(run) > 0 {
+		if err := en.spill(run); err != nil {
+			return err
+		}
+	}
+	maxOpen := en.oe.ext.MaxOpenRuns
+	if maxOpen < 2 {
+		maxOpen = defaultMaxOpenRuns
+	}
+	// the intermediate passes merge the groups of consecutive runs, so the sorting remains stable
+	for len(en.runs) > maxOpen {
+		var merged []string
+		for i := 0; i < len(en.runs); i += maxOpen {
+			j := i + maxOpen
+			if j > len(en.runs) {
+				j = len(en.runs)
+			}
+			if j-i == 1 {
+				merged = append(merged, en.runs[i])
+				continue
+			}
+			name, err := en.mergeRuns(en.runs[i:j])
+			if err != nil {
+				// the runs not merged yet are still to be deleted
+				en.runs = append(merged, en.runs[i:]...)
+				return err
+			}
+			merged = append(merged, name)
+		}
+		en.runs = merged
+	}
+	var err error
+	en.h, en.open, err = en.openRuns(en.runs)
+	return err
+}
+
+// cleanup closes and deletes the temporary files
+func (en *externalEnumerator[T]) cleanup() {
+	for _, f := range en.open {
+		f.Close()
+	}
+	for _, name := range en.runs {
+		os.Remove(name)
+	}
+	en.open = nil
+	en.runs = nil
+	en.h = nil
+}
+
+// MoveNext implements the Enumerator.MoveNext method.
+func (en *externalEnumerator[T]) MoveNext() bool {
+	if en.err != nil {
+		return false
+	}
+	if !en.started {
+		if en.err = en.start(); en.err != nil {
+			en.cleanup()
+			return false
+		}
+	}
+	if en.h == nil {
+		if en.idx >= len(en.mem) {
+			return false
+		}
+		en.crrnt = en.mem[en.idx]
+		en.idx++
+		return true
+	}
+	el, ok, err := en.h.next()
+	if err != nil {
+		en.err = err
+	}
+	if !ok {
+		en.cleanup()
+		return false
+	}
+	en.crrnt = el
+	return true
+}
+
+// Current implements the Enumerator.Current method.
+func (en *externalEnumerator[T]) Current() T {
+	return en.crrnt
+}
+
+// Reset implements the Enumerator.Reset method.
+func (en *externalEnumerator[T]) Reset() {
+	en.cleanup()
+	en.oe.en.Reset()
+	en.started = false
+	en.mem = nil
+	en.idx = 0
+	en.crrnt = ZeroValue[T]()
+	en.err = nil
+}
+
+// Err implements the ErrEnumerator.Err method.
+func (en *externalEnumerator[T]) Err() error {
+	return en.err
+}
+
+// Close implements the io.Closer interface.
+func (en *externalEnumerator[T]) Close() error {
+	en.cleanup()
+	return Close(en.oe.en)
+}
@@ -0,0 +1,147 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"encoding/binary"
+	"io"
+	"os"
+	"reflect"
+	"testing"
+)
+
+type extRow struct {
+	Key   int
+	Name  string
+	Order int
+}
+
+func extRows(n int) []extRow {
+	rr := make([]extRow, n)
+	for i := range rr {
+		rr[i] = extRow{Key: (i * 7919) % 13, Name: string(rune('a' + (i*31)%7)), Order: i}
+	}
+	return rr
+}
+
+func tempFiles(t *testing.T, dir string) int {
+	ff, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(ff)
+}
+
+func TestOrderByExternal_ThenBy(t *testing.T) {
+	rows := extRows(1000)
+	want := Slice(ThenByDescendingLsMust(
+		OrderByLsMust(NewOnSliceEn(rows...), func(r extRow) int { return r.Key }, Lesser[int](Order[int]{})),
+		func(r extRow) string { return r.Name }, Lesser[string](Order[string]{}),
+	).GetEnumerator())
+	tests := []struct {
+		name        string
+		runSize     int
+		maxOpenRuns int
+	}{
+		{name: "SingleRun", runSize: 0},
+		{name: "ManyRuns", runSize: 37},
+		{name: "RunPerElement", runSize: 1},
+		{name: "MergePasses", runSize: 3, maxOpenRuns: 2},
+		{name: "MergePassesUneven", runSize: 7, maxOpenRuns: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			oe := OrderByExternalMust(NewOnSliceEn(rows...), func(r extRow) int { return r.Key }, Lesser[int](Order[int]{}),
+				ExternalSortOptions[extRow]{RunSize: tt.runSize, Dir: dir, MaxOpenRuns: tt.maxOpenRuns})
+			en := ThenByDescendingLsMust(oe, func(r extRow) string { return r.Name }, Lesser[string](Order[string]{})).GetEnumerator()
+			got, err := SliceErr(en)
+			if err != nil {
+				t.Fatalf("OrderByExternal() error = '%v'", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("OrderByExternal() = '%v', want '%v'", got, want)
+			}
+			if n := tempFiles(t, dir); n != 0 {
+				t.Errorf("OrderByExternal() left %d temporary files", n)
+			}
+		})
+	}
+}
+
+func TestOrderByExternal_Close(t *testing.T) {
+	dir := t.TempDir()
+	en := OrderByDescendingExternalMust(RangeMust(0, 100), Identity[int], Lesser[int](Order[int]{}),
+		ExternalSortOptions[int]{RunSize: 10, Dir: dir}).GetEnumerator()
+	if got := FirstMust(en); got != 99 {
+		t.Errorf("First(OrderByDescendingExternal()) = %v, want %v", got, 99)
+	}
+	if n := tempFiles(t, dir); n != 0 {
+		t.Errorf("OrderByDescendingExternal() left %d temporary files after Close", n)
+	}
+	en.Reset()
+	if got := Slice(TakeMust(en, 3)); !reflect.DeepEqual(got, []int{99, 98, 97}) {
+		t.Errorf("OrderByDescendingExternal() after Reset = '%v', want '%v'", got, []int{99, 98, 97})
+	}
+}
+
+func TestOrderByExternal_MaxOpenRuns(t *testing.T) {
+	dir := t.TempDir()
+	en := OrderByExternalMust(RangeMust(0, 1000), Identity[int], Lesser[int](Order[int]{}),
+		ExternalSortOptions[int]{RunSize: 10, Dir: dir, MaxOpenRuns: 3}).GetEnumerator()
+	if !en.MoveNext() || en.Current() != 0 {
+		t.Fatalf("OrderByExternal() first = %v, want %v", en.Current(), 0)
+	}
+	if n := tempFiles(t, dir); n > 3 {
+		t.Errorf("OrderByExternal() merges %d runs, want at most %d", n, 3)
+	}
+	want := 1
+	for en.MoveNext() {
+		if en.Current() != want {
+			t.Fatalf("OrderByExternal() = %v, want %v", en.Current(), want)
+		}
+		want++
+	}
+	if want != 1000 {
+		t.Errorf("OrderByExternal() returned %d elements, want %d", want, 1000)
+	}
+	if n := tempFiles(t, dir); n != 0 {
+		t.Errorf("OrderByExternal() left %d temporary files", n)
+	}
+}
+
+func TestOrderByExternal_errors(t *testing.T) {
+	dir := t.TempDir()
+	en := OrderByExternalMust[int, int](failAt(RangeMust(0, 100), 50), Identity[int], Order[int]{},
+		ExternalSortOptions[int]{RunSize: 10, Dir: dir}).GetEnumerator()
+	if _, err := SliceErr(en); err != errTest {
+		t.Errorf("OrderByExternal() error = '%v', want '%v'", err, errTest)
+	}
+	if n := tempFiles(t, dir); n != 0 {
+		t.Errorf("OrderByExternal() left %d temporary files after error", n)
+	}
+}
+
+// int64Codec is a Codec for int that uses fixed-size binary encoding
+type int64Codec struct{}
+
+func (int64Codec) NewEncoder(w io.Writer) func(int) error {
+	return func(i int) error { return binary.Write(w, binary.LittleEndian, int64(i)) }
+}
+
+func (int64Codec) NewDecoder(r io.Reader) func() (int, error) {
+	return func() (int, error) {
+		var i int64
+		err := binary.Read(r, binary.LittleEndian, &i)
+		return int(i), err
+	}
+}
+
+func TestOrderByExternal_Codec(t *testing.T) {
+	src := SelectMust(RangeMust(0, 500), func(i int) int { return (i * 7919) % 500 })
+	got := Slice(OrderByExternalMust(src, Identity[int], Lesser[int](Order[int]{}),
+		ExternalSortOptions[int]{RunSize: 64, Codec: int64Codec{}, Dir: t.TempDir()}).GetEnumerator())
+	if want := Slice(RangeMust(0, 500)); !reflect.DeepEqual(got, want) {
+		t.Errorf("OrderByExternal() = '%v', want '%v'", got, want)
+	}
+}
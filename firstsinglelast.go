@@ -16,6 +16,9 @@ func First[Source any](source Enumerator[Source]) (Source, error) {
 	if source == nil {
 		return ZeroValue[Source](), ErrNilSource
 	}
+	if op, ok := source.(orderedPartition[Source]); ok && op.fresh() {
+		return op.first()
+	}
 	if counter, cok := source.(Counter); cok {
 		if counter.Count() == 0 {
 			return ZeroValue[Source](), ErrEmptySource
@@ -25,9 +28,14 @@ func First[Source any](source Enumerator[Source]) (Source, error) {
 		}
 	}
 	if !source.MoveNext() {
+		if err := Err(source); err != nil {
+			return ZeroValue[Source](), err
+		}
 		return ZeroValue[Source](), ErrEmptySource
 	}
-	return source.Current(), nil
+	r := source.Current()
+	Close(source)
+	return r, nil
 }
 
 // FirstMust is like First but panics in case of error.
@@ -48,18 +56,26 @@ func FirstPred[Source any](source Enumerator[Source], predicate func(Source) boo
 		return ZeroValue[Source](), ErrNilPredicate
 	}
 	if !source.MoveNext() {
+		if err := Err(source); err != nil {
+			return ZeroValue[Source](), err
+		}
 		return ZeroValue[Source](), ErrEmptySource
 	}
 	r := source.Current()
 	if predicate(r) {
+		Close(source)
 		return r, nil
 	}
 	for source.MoveNext() {
 		r = source.Current()
 		if predicate(r) {
+			Close(source)
 			return r, nil
 		}
 	}
+	if err := Err(source); err != nil {
+		return ZeroValue[Source](), err
+	}
 	return ZeroValue[Source](), ErrNoMatch
 }
 
@@ -78,9 +94,12 @@ func FirstOrDefault[Source any](source Enumerator[Source]) (Source, error) {
 		return ZeroValue[Source](), ErrNilSource
 	}
 	r, err := First(source)
-	if err != nil {
+	if err == ErrEmptySource || err == ErrNoMatch {
 		return ZeroValue[Source](), nil
 	}
+	if err != nil {
+		return ZeroValue[Source](), err
+	}
 	return r, nil
 }
 
@@ -102,9 +121,12 @@ func FirstOrDefaultPred[Source any](source Enumerator[Source], predicate func(So
 		return ZeroValue[Source](), ErrNilPredicate
 	}
 	r, err := FirstPred(source, predicate)
-	if err != nil {
+	if err == ErrEmptySource || err == ErrNoMatch {
 		return ZeroValue[Source](), nil
 	}
+	if err != nil {
+		return ZeroValue[Source](), err
+	}
 	return r, nil
 }
 
@@ -134,12 +156,20 @@ func Single[Source any](source Enumerator[Source]) (Source, error) {
 		}
 	}
 	if !source.MoveNext() {
+		if err := Err(source); err != nil {
+			return ZeroValue[Source](), err
+		}
 		return ZeroValue[Source](), ErrEmptySource
 	}
+	r := source.Current()
 	if source.MoveNext() {
+		Close(source)
 		return ZeroValue[Source](), ErrMultipleElements
 	}
-	return source.Current(), nil
+	if err := Err(source); err != nil {
+		return ZeroValue[Source](), err
+	}
+	return r, nil
 }
 
 // SingleMust is like Single but panics in case of error.
@@ -167,12 +197,16 @@ func SinglePred[Source any](source Enumerator[Source], predicate func(Source) bo
 		c := source.Current()
 		if predicate(c) {
 			if found {
+				Close(source)
 				return ZeroValue[Source](), ErrMultipleMatch
 			}
 			found = true
 			r = c
 		}
 	}
+	if err := Err(source); err != nil {
+		return ZeroValue[Source](), err
+	}
 	if empty {
 		return ZeroValue[Source](), ErrEmptySource
 	}
@@ -197,12 +231,12 @@ func SingleOrDefault[Source any](source Enumerator[Source]) (Source, error) {
 		return ZeroValue[Source](), ErrNilSource
 	}
 	r, err := Single(source)
-	if err != nil {
-		if err == ErrMultipleElements {
-			return ZeroValue[Source](), ErrMultipleElements
-		}
+	if err == ErrEmptySource || err == ErrNoMatch {
 		return ZeroValue[Source](), nil
 	}
+	if err != nil {
+		return ZeroValue[Source](), err
+	}
 	return r, nil
 }
 
@@ -225,12 +259,12 @@ func SingleOrDefaultPred[Source any](source Enumerator[Source], predicate func(S
 		return ZeroValue[Source](), ErrNilPredicate
 	}
 	r, err := SinglePred(source, predicate)
-	if err != nil {
-		if err == ErrMultipleMatch {
-			return ZeroValue[Source](), ErrMultipleMatch
-		}
+	if err == ErrEmptySource || err == ErrNoMatch {
 		return ZeroValue[Source](), nil
 	}
+	if err != nil {
+		return ZeroValue[Source](), err
+	}
 	return r, nil
 }
 
@@ -248,6 +282,9 @@ func Last[Source any](source Enumerator[Source]) (Source, error) {
 	if source == nil {
 		return ZeroValue[Source](), ErrNilSource
 	}
+	if op, ok := source.(orderedPartition[Source]); ok && op.fresh() {
+		return op.last()
+	}
 	if counter, cok := source.(Counter); cok {
 		len := counter.Count()
 		if len == 0 {
@@ -258,12 +295,18 @@ func Last[Source any](source Enumerator[Source]) (Source, error) {
 		}
 	}
 	if !source.MoveNext() {
+		if err := Err(source); err != nil {
+			return ZeroValue[Source](), err
+		}
 		return ZeroValue[Source](), ErrEmptySource
 	}
 	r := source.Current()
 	for source.MoveNext() {
 		r = source.Current()
 	}
+	if err := Err(source); err != nil {
+		return ZeroValue[Source](), err
+	}
 	return r, nil
 }
 
@@ -285,6 +328,9 @@ func LastPred[Source any](source Enumerator[Source], predicate func(Source) bool
 		return ZeroValue[Source](), ErrNilPredicate
 	}
 	if !source.MoveNext() {
+		if err := Err(source); err != nil {
+			return ZeroValue[Source](), err
+		}
 		return ZeroValue[Source](), ErrEmptySource
 	}
 	found := false
@@ -301,6 +347,9 @@ func LastPred[Source any](source Enumerator[Source], predicate func(Source) bool
 			r = c
 		}
 	}
+	if err := Err(source); err != nil {
+		return ZeroValue[Source](), err
+	}
 	if !found {
 		return ZeroValue[Source](), ErrNoMatch
 	}
@@ -322,9 +371,12 @@ func LastOrDefault[Source any](source Enumerator[Source]) (Source, error) {
 		return ZeroValue[Source](), ErrNilSource
 	}
 	r, err := Last(source)
-	if err != nil {
+	if err == ErrEmptySource || err == ErrNoMatch {
 		return ZeroValue[Source](), nil
 	}
+	if err != nil {
+		return ZeroValue[Source](), err
+	}
 	return r, nil
 }
 
@@ -347,9 +399,12 @@ func LastOrDefaultPred[Source any](source Enumerator[Source], predicate func(Sou
 		return ZeroValue[Source](), ErrNilPredicate
 	}
 	r, err := LastPred(source, predicate)
-	if err != nil {
+	if err == ErrEmptySource || err == ErrNoMatch {
 		return ZeroValue[Source](), nil
 	}
+	if err != nil {
+		return ZeroValue[Source](), err
+	}
 	return r, nil
 }
 
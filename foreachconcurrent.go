@@ -0,0 +1,161 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ForEachOptions configures ForEachConcurrentOpts and ForEachConcurrentResult.
+type ForEachOptions struct {
+	// MaxWorkers is the maximum number of concurrently executing actions.
+	// The number of concurrently executing actions is not limited if MaxWorkers is not positive.
+	MaxWorkers int
+	// ContinueOnError determines whether the remaining actions are performed after an action has failed.
+	// If ContinueOnError is false (fail-fast), the first error cancels the context passed to the executing actions
+	// and no new actions are started.
+	ContinueOnError bool
+	// JoinErrors determines whether all the errors returned by the actions are returned (joined with errors.Join).
+	// Otherwise only the first error is returned.
+	JoinErrors bool
+}
+
+// forEachConcurrent performs 'action' on each element of 'en' according to 'opts'
+// 'action' receives the index of the element starting from zero.
+// forEachConcurrent returns the number of the elements taken from 'en'.
+func forEachConcurrent[T any](ctx context.Context, en Enumerator[T],
+	action func(context.Context, int, T) error, opts ForEachOptions) (int, error) {
+	actx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var sem chan struct{}
+	if opts.MaxWorkers > 0 {
+		sem = make(chan struct{}, opts.MaxWorkers)
+	}
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		errs   []error
+		failed bool
+	)
+	idx := 0
+	stopped := false
+	for !stopped && en.MoveNext() {
+		if sem != nil {
+			select {
+			case <-actx.Done():
+				stopped = true
+				continue
+			case sem <- struct{}{}:
+			}
+		} else if actx.Err() != nil {
+			stopped = true
+			continue
+		}
+		c, i := en.Current(), idx
+		idx++
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if sem != nil {
+				defer func() { <-sem }()
+			}
+			if actx.Err() != nil {
+				return
+			}
+			err := action(actx, i, c)
+			if err == nil {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			// the cancellations caused by the fail-fast are not reported
+			if failed && !opts.ContinueOnError && errors.Is(err, context.Canceled) {
+				return
+			}
+			failed = true
+			errs = append(errs, err)
+			if !opts.ContinueOnError {
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+	if stopped {
+		Close(en)
+	}
+	if len(errs) > 0 {
+		if opts.JoinErrors {
+			return idx, joinErrors(errs)
+		}
+		return idx, errs[0]
+	}
+	if err := ctx.Err(); err != nil {
+		return idx, err
+	}
+	return idx, Err(en)
+}
+
+// ForEachConcurrentOpts concurrently performs the specified action on each element of the sequence starting from the current.
+// 'ctx' may be used to cancel the operation in progress.
+// 'opts' limits the number of concurrently executing actions and determines the errors handling (see ForEachOptions).
+func ForEachConcurrentOpts[T any](ctx context.Context, en Enumerator[T],
+	action func(context.Context, T) error, opts ForEachOptions) error {
+	if en == nil {
+		return ErrNilSource
+	}
+	if action == nil {
+		return ErrNilAction
+	}
+	_, err := forEachConcurrent(ctx, en, func(ctx context.Context, _ int, el T) error { return action(ctx, el) }, opts)
+	return err
+}
+
+// ForEachConcurrentResult concurrently performs the specified action on each element of the sequence starting from the current
+// and returns the actions' results in the order of the corresponding elements.
+// 'ctx' may be used to cancel the operation in progress.
+// 'opts' limits the number of concurrently executing actions and determines the errors handling (see ForEachOptions).
+//
+// The results stay aligned with the elements: the i-th result corresponds to the i-th element taken from the sequence.
+// If an error occurs, ForEachConcurrentResult returns the error along with the results,
+// where the results of the failed (or not performed due to the fail-fast) actions are zero values of Result.
+// The elements not taken from the sequence due to the fail-fast have no results.
+func ForEachConcurrentResult[T, Result any](ctx context.Context, en Enumerator[T],
+	action func(context.Context, T) (Result, error), opts ForEachOptions) (Enumerator[Result], error) {
+	if en == nil {
+		return nil, ErrNilSource
+	}
+	if action == nil {
+		return nil, ErrNilAction
+	}
+	var mu sync.Mutex
+	var rr []Result
+	n, err := forEachConcurrent(ctx, en, func(ctx context.Context, i int, el T) error {
+		r, err := action(ctx, el)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if i >= len(rr) {
+			rr = append(rr, make([]Result, i-len(rr)+1)...)
+		}
+		rr[i] = r
+		return nil
+	}, opts)
+	if n > len(rr) {
+		rr = append(rr, make([]Result, n-len(rr))...)
+	}
+	return NewOnSlice(rr...), err
+}
+
+// ForEachConcurrentResultMust is like ForEachConcurrentResult but panics in case of error.
+func ForEachConcurrentResultMust[T, Result any](ctx context.Context, en Enumerator[T],
+	action func(context.Context, T) (Result, error), opts ForEachOptions) Enumerator[Result] {
+	r, err := ForEachConcurrentResult(ctx, en, action, opts)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,136 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestForEachConcurrentOpts_MaxWorkers(t *testing.T) {
+	var running, maxRunning, count int32
+	action := func(_ context.Context, _ int) error {
+		r := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if r <= m || atomic.CompareAndSwapInt32(&maxRunning, m, r) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		atomic.AddInt32(&count, 1)
+		return nil
+	}
+	err := ForEachConcurrentOpts(context.Background(), RangeMust(0, 50), action, ForEachOptions{MaxWorkers: 4})
+	if err != nil {
+		t.Fatalf("ForEachConcurrentOpts() error = '%v'", err)
+	}
+	if count != 50 {
+		t.Errorf("ForEachConcurrentOpts() performed %v actions, want %v", count, 50)
+	}
+	if maxRunning > 4 {
+		t.Errorf("ForEachConcurrentOpts() ran %v actions concurrently, want at most %v", maxRunning, 4)
+	}
+}
+
+func TestForEachConcurrentOpts_FailFast(t *testing.T) {
+	action := func(ctx context.Context, i int) error {
+		if i == 3 {
+			return errTest
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Second):
+			return nil
+		}
+	}
+	start := time.Now()
+	err := ForEachConcurrentOpts(context.Background(), RangeMust(0, 5), action, ForEachOptions{})
+	if err != errTest {
+		t.Errorf("ForEachConcurrentOpts() error = '%v', want '%v'", err, errTest)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("ForEachConcurrentOpts() did not cancel the sibling actions")
+	}
+}
+
+func TestForEachConcurrentOpts_JoinErrors(t *testing.T) {
+	action := func(_ context.Context, i int) error {
+		if i%2 == 0 {
+			return fmt.Errorf("error %d", i)
+		}
+		return nil
+	}
+	err := ForEachConcurrentOpts(context.Background(), RangeMust(0, 10), action,
+		ForEachOptions{MaxWorkers: 2, ContinueOnError: true, JoinErrors: true})
+	if err == nil {
+		t.Fatalf("ForEachConcurrentOpts() error = nil, want joined errors")
+	}
+	if got := len(strings.Split(err.Error(), "\n")); got != 5 {
+		t.Errorf("ForEachConcurrentOpts() returned %v errors, want %v: '%v'", got, 5, err)
+	}
+}
+
+func TestForEachConcurrentOpts_nil(t *testing.T) {
+	if err := ForEachConcurrentOpts[int](context.Background(), nil, nil, ForEachOptions{}); err != ErrNilSource {
+		t.Errorf("ForEachConcurrentOpts() error = '%v', want '%v'", err, ErrNilSource)
+	}
+	if err := ForEachConcurrentOpts(context.Background(), RangeMust(0, 1), nil, ForEachOptions{}); err != ErrNilAction {
+		t.Errorf("ForEachConcurrentOpts() error = '%v', want '%v'", err, ErrNilAction)
+	}
+}
+
+func TestForEachConcurrentResult_int(t *testing.T) {
+	action := func(_ context.Context, i int) (string, error) {
+		time.Sleep(time.Duration(10-i) * time.Millisecond)
+		if i == 7 {
+			return "", errTest
+		}
+		return fmt.Sprint(i * i), nil
+	}
+	tests := []struct {
+		name    string
+		en      Enumerator[int]
+		opts    ForEachOptions
+		want    []string
+		wantErr error
+	}{
+		{name: "Ordered",
+			en:   RangeMust(1, 5),
+			opts: ForEachOptions{MaxWorkers: 3},
+			want: []string{"1", "4", "9", "16", "25"},
+		},
+		{name: "ContinueOnError",
+			en:      RangeMust(5, 5),
+			opts:    ForEachOptions{ContinueOnError: true},
+			want:    []string{"25", "36", "", "64", "81"},
+			wantErr: errTest,
+		},
+		{name: "FailFastAligned",
+			en:      RangeMust(7, 1),
+			opts:    ForEachOptions{},
+			want:    []string{""},
+			wantErr: errTest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ForEachConcurrentResult(context.Background(), tt.en, action, tt.opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ForEachConcurrentResult() error = '%v', wantErr '%v'", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(Slice(got), tt.want) {
+				got.Reset()
+				t.Errorf("ForEachConcurrentResult() = '%v', want '%v'", String(got), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+//go:build go1.18
+
+package go2linq
+
+// https://en.wikipedia.org/wiki/Join_(SQL)#Full_outer_join
+
+// FullOuterJoin correlates the elements of two sequences based on matching keys (full outer join).
+// Each element of 'outer' is paired with each matching element of 'inner'
+// or with nil if there are no matching elements.
+// Then each element of 'inner' not matched by any element of 'outer' is paired with nil.
+// reflect.DeepEqual is used to compare keys. 'inner' is enumerated immediately.
+// 'outer' and 'inner' must not be based on the same Enumerator, otherwise use FullOuterJoinSelf instead.
+func FullOuterJoin[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, *Inner) Result) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return outerJoin(outer, inner, outerKeySelector, innerKeySelector, resultSelector, lookupEqFactory[Key](nil), true), nil
+}
+
+// FullOuterJoinMust is like FullOuterJoin but panics in case of error.
+func FullOuterJoinMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, *Inner) Result) Enumerator[Result] {
+	r, err := FullOuterJoin(outer, inner, outerKeySelector, innerKeySelector, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// FullOuterJoinSelf correlates the elements of two sequences based on matching keys (full outer join).
+// Each element of 'outer' is paired with each matching element of 'inner'
+// or with nil if there are no matching elements.
+// Then each element of 'inner' not matched by any element of 'outer' is paired with nil.
+// reflect.DeepEqual is used to compare keys. 'inner' is enumerated immediately.
+// 'outer' and 'inner' may be based on the same Enumerator.
+// 'outer' must have real Reset method.
+func FullOuterJoinSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, *Inner) Result) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return outerJoinSelf(outer, inner, outerKeySelector, innerKeySelector, resultSelector, lookupEqFactory[Key](nil), true)
+}
+
+// FullOuterJoinSelfMust is like FullOuterJoinSelf but panics in case of error.
+func FullOuterJoinSelfMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, *Inner) Result) Enumerator[Result] {
+	r, err := FullOuterJoinSelf(outer, inner, outerKeySelector, innerKeySelector, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// FullOuterJoinEq correlates the elements of two sequences based on matching keys (full outer join).
+// Each element of 'outer' is paired with each matching element of 'inner'
+// or with nil if there are no matching elements.
+// Then each element of 'inner' not matched by any element of 'outer' is paired with nil.
+// A specified Equaler is used to compare keys.
+// If 'equaler' is nil reflect.DeepEqual is used. 'inner' is enumerated immediately.
+// 'outer' and 'inner' must not be based on the same Enumerator, otherwise use FullOuterJoinEqSelf instead.
+func FullOuterJoinEq[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, *Inner) Result, equaler Equaler[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return outerJoin(outer, inner, outerKeySelector, innerKeySelector, resultSelector, lookupEqFactory(equaler), true), nil
+}
+
+// FullOuterJoinEqMust is like FullOuterJoinEq but panics in case of error.
+func FullOuterJoinEqMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, *Inner) Result, equaler Equaler[Key]) Enumerator[Result] {
+	r, err := FullOuterJoinEq(outer, inner, outerKeySelector, innerKeySelector, resultSelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// FullOuterJoinEqSelf correlates the elements of two sequences based on matching keys (full outer join).
+// Each element of 'outer' is paired with each matching element of 'inner'
+// or with nil if there are no matching elements.
+// Then each element of 'inner' not matched by any element of 'outer' is paired with nil.
+// A specified Equaler is used to compare keys.
+// If 'equaler' is nil reflect.DeepEqual is used. 'inner' is enumerated immediately.
+// 'outer' and 'inner' may be based on the same Enumerator.
+// 'outer' must have real Reset method.
+func FullOuterJoinEqSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, *Inner) Result, equaler Equaler[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return outerJoinSelf(outer, inner, outerKeySelector, innerKeySelector, resultSelector, lookupEqFactory(equaler), true)
+}
+
+// FullOuterJoinEqSelfMust is like FullOuterJoinEqSelf but panics in case of error.
+func FullOuterJoinEqSelfMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, *Inner) Result, equaler Equaler[Key]) Enumerator[Result] {
+	r, err := FullOuterJoinEqSelf(outer, inner, outerKeySelector, innerKeySelector, resultSelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// FullOuterJoinCmp correlates the elements of two sequences based on matching keys (full outer join).
+// Each element of 'outer' is paired with each matching element of 'inner'
+// or with nil if there are no matching elements.
+// Then each element of 'inner' not matched by any element of 'outer' is paired with nil.
+// A specified Comparer is used to compare keys. 'inner' is enumerated immediately.
+// 'outer' and 'inner' must not be based on the same Enumerator, otherwise use FullOuterJoinCmpSelf instead.
+func FullOuterJoinCmp[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, *Inner) Result, comparer Comparer[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return outerJoin(outer, inner, outerKeySelector, innerKeySelector, resultSelector, lookupCmpFactory(comparer), true), nil
+}
+
+// FullOuterJoinCmpMust is like FullOuterJoinCmp but panics in case of error.
+func FullOuterJoinCmpMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, *Inner) Result, comparer Comparer[Key]) Enumerator[Result] {
+	r, err := FullOuterJoinCmp(outer, inner, outerKeySelector, innerKeySelector, resultSelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// FullOuterJoinCmpSelf correlates the elements of two sequences based on matching keys (full outer join).
+// Each element of 'outer' is paired with each matching element of 'inner'
+// or with nil if there are no matching elements.
+// Then each element of 'inner' not matched by any element of 'outer' is paired with nil.
+// A specified Comparer is used to compare keys. 'inner' is enumerated immediately.
+// 'outer' and 'inner' may be based on the same Enumerator.
+// 'outer' must have real Reset method.
+func FullOuterJoinCmpSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, *Inner) Result, comparer Comparer[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return outerJoinSelf(outer, inner, outerKeySelector, innerKeySelector, resultSelector, lookupCmpFactory(comparer), true)
+}
+
+// FullOuterJoinCmpSelfMust is like FullOuterJoinCmpSelf but panics in case of error.
+func FullOuterJoinCmpSelfMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, *Inner) Result, comparer Comparer[Key]) Enumerator[Result] {
+	r, err := FullOuterJoinCmpSelf(outer, inner, outerKeySelector, innerKeySelector, resultSelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
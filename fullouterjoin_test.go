@@ -0,0 +1,101 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_FullOuterJoinMust(t *testing.T) {
+	type args struct {
+		outer            Enumerator[string]
+		inner            Enumerator[string]
+		outerKeySelector func(string) rune
+		innerKeySelector func(string) rune
+	}
+	tests := []struct {
+		name string
+		args args
+		want Enumerator[string]
+	}{
+		{name: "SimpleFullOuterJoin",
+			args: args{
+				outer:            NewOnSlice("first", "second", "third"),
+				inner:            NewOnSlice("essence", "offer", "eating", "psalm"),
+				outerKeySelector: func(oel string) rune { return ([]rune(oel))[0] },
+				innerKeySelector: func(iel string) rune { return ([]rune(iel))[1] },
+			},
+			want: NewOnSlice("first:offer", "second:essence", "second:psalm", "third:-", "-:eating"),
+		},
+		{name: "EmptyOuter",
+			args: args{
+				outer:            Empty[string](),
+				inner:            NewOnSlice("first", "second"),
+				outerKeySelector: func(oel string) rune { return ([]rune(oel))[0] },
+				innerKeySelector: func(iel string) rune { return ([]rune(iel))[0] },
+			},
+			want: NewOnSlice("-:first", "-:second"),
+		},
+		{name: "EmptyInner",
+			args: args{
+				outer:            NewOnSlice("first", "second"),
+				inner:            Empty[string](),
+				outerKeySelector: func(oel string) rune { return ([]rune(oel))[0] },
+				innerKeySelector: func(iel string) rune { return ([]rune(iel))[0] },
+			},
+			want: NewOnSlice("first:-", "second:-"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FullOuterJoinMust(tt.args.outer, tt.args.inner, tt.args.outerKeySelector, tt.args.innerKeySelector,
+				joinResult[string, string])
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("FullOuterJoin() = '%v', want '%v'", String(got), String(tt.want))
+			}
+		})
+	}
+}
+
+func Test_FullOuterJoin_Reset(t *testing.T) {
+	got := FullOuterJoinMust(NewOnSlice(0, 1, 2), NewOnSlice(0, 2, 3), Identity[int], Identity[int], joinResult[int, int])
+	want := NewOnSlice("0:0", "1:-", "2:2", "-:3")
+	for i := 0; i < 2; i++ {
+		if !SequenceEqualMust(got, want) {
+			got.Reset()
+			want.Reset()
+			t.Errorf("FullOuterJoin() = '%v', want '%v'", String(got), String(want))
+		}
+		got.Reset()
+		want.Reset()
+	}
+}
+
+func Test_FullOuterJoinCmpMust(t *testing.T) {
+	got := FullOuterJoinCmpMust(
+		NewOnSlice("ABCxxx", "defzzz"),
+		NewOnSlice("000abc", "111gHi", "222Abc"),
+		func(oel string) string { return oel[:3] },
+		func(iel string) string { return iel[3:] },
+		joinResult[string, string],
+		CaseInsensitiveComparer,
+	)
+	want := NewOnSlice("ABCxxx:000abc", "ABCxxx:222Abc", "defzzz:-", "-:111gHi")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("FullOuterJoinCmp() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_FullOuterJoin_errors(t *testing.T) {
+	if _, err := FullOuterJoin(NewOnSliceEn(1), nil, Identity[int], Identity[int], joinResult[int, int]); err != ErrNilSource {
+		t.Errorf("FullOuterJoin() error = '%v', want '%v'", err, ErrNilSource)
+	}
+	got := FullOuterJoinMust[int, int, int, string](failAt(NewOnSliceEn(1, 2), 2), NewOnSliceEn(1, 3), Identity[int], Identity[int], joinResult[int, int])
+	if _, err := SliceErr(got); err != errTest {
+		t.Errorf("FullOuterJoin() error = '%v', want '%v'", err, errTest)
+	}
+}
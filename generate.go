@@ -0,0 +1,97 @@
+//go:build go1.18
+
+package go2linq
+
+// https://morelinq.github.io/3.3/ref/api/html/M_MoreLinq_MoreEnumerable_Generate__1.htm
+// https://morelinq.github.io/3.3/ref/api/html/M_MoreLinq_MoreEnumerable_Unfold__3.htm
+// https://hackage.haskell.org/package/base/docs/Prelude.html#v:iterate
+
+// Generate generates an infinite sequence starting with 'seed',
+// each next element is obtained by invoking 'next' on the previous one
+// (i.e. seed, next(seed), next(next(seed)), ...).
+// The elements are generated lazily, use Take or TakeWhile to limit the sequence.
+func Generate[Result any](seed Result, next func(Result) Result) (Enumerator[Result], error) {
+	if next == nil {
+		return nil, ErrNilSelector
+	}
+	var c Result
+	started := false
+	return OnFunc[Result]{
+			mvNxt: func() bool {
+				if started {
+					c = next(c)
+				} else {
+					c, started = seed, true
+				}
+				return true
+			},
+			crrnt: func() Result { return c },
+			rst:   func() { started = false },
+		},
+		nil
+}
+
+// GenerateMust is like Generate but panics in case of error.
+func GenerateMust[Result any](seed Result, next func(Result) Result) Enumerator[Result] {
+	r, err := Generate(seed, next)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Iterate generates an infinite sequence of repeated applications of 'f' to 'x'
+// (i.e. x, f(x), f(f(x)), ...), as Haskell's iterate does. (See Generate function.)
+func Iterate[Result any](f func(Result) Result, x Result) (Enumerator[Result], error) {
+	return Generate(x, f)
+}
+
+// IterateMust is like Iterate but panics in case of error.
+func IterateMust[Result any](f func(Result) Result, x Result) Enumerator[Result] {
+	r, err := Iterate(f, x)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Unfold generates a sequence from the initial 'state'.
+// 'generator' returns the next element and the next state for the current state,
+// the sequence ends when 'generator' returns false (the element returned along with false is not included).
+// The elements are generated lazily, so the sequence may be infinite.
+func Unfold[State, Result any](state State, generator func(State) (Result, State, bool)) (Enumerator[Result], error) {
+	if generator == nil {
+		return nil, ErrNilSelector
+	}
+	s := state
+	var c Result
+	done := false
+	return OnFunc[Result]{
+			mvNxt: func() bool {
+				if done {
+					return false
+				}
+				r, next, ok := generator(s)
+				if !ok {
+					done = true
+					return false
+				}
+				c, s = r, next
+				return true
+			},
+			crrnt: func() Result { return c },
+			rst: func() {
+				s, done = state, false
+			},
+		},
+		nil
+}
+
+// UnfoldMust is like Unfold but panics in case of error.
+func UnfoldMust[State, Result any](state State, generator func(State) (Result, State, bool)) Enumerator[Result] {
+	r, err := Unfold(state, generator)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
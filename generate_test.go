@@ -0,0 +1,53 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_Generators(t *testing.T) {
+	fib := func(s [2]int) (int, [2]int, bool) { return s[0], [2]int{s[1], s[0] + s[1]}, true }
+	countdown := func(n int) (int, int, bool) { return n, n - 1, n > 0 }
+	tests := []struct {
+		name string
+		got  Enumerator[int]
+		want Enumerator[int]
+	}{
+		{name: "Generate", got: TakeMust(GenerateMust(1, func(x int) int { return 2 * x }), 5), want: NewOnSlice(1, 2, 4, 8, 16)},
+		{name: "Iterate", got: TakeMust(IterateMust(func(x int) int { return x + 3 }, 0), 4), want: NewOnSlice(0, 3, 6, 9)},
+		{name: "IterateTakeWhile",
+			got:  TakeWhileMust(IterateMust(func(x int) int { return x * x }, 2), func(x int) bool { return x < 1000 }),
+			want: NewOnSlice(2, 4, 16, 256),
+		},
+		{name: "UnfoldInfinite", got: TakeMust(UnfoldMust([2]int{0, 1}, fib), 8), want: NewOnSlice(0, 1, 1, 2, 3, 5, 8, 13)},
+		{name: "UnfoldFinite", got: UnfoldMust(3, countdown), want: NewOnSlice(3, 2, 1)},
+		{name: "UnfoldEmpty", got: UnfoldMust(0, countdown), want: Empty[int]()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !SequenceEqualMust(tt.got, tt.want) {
+				tt.got.Reset()
+				tt.want.Reset()
+				t.Errorf("%s() = '%v', want '%v'", tt.name, String(tt.got), String(tt.want))
+			}
+			tt.got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(tt.got, tt.want) {
+				t.Errorf("%s() after Reset differs", tt.name)
+			}
+		})
+	}
+}
+
+func Test_Generators_errors(t *testing.T) {
+	if _, err := Generate[int](0, nil); err != ErrNilSelector {
+		t.Errorf("Generate() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+	if _, err := Iterate[int](nil, 0); err != ErrNilSelector {
+		t.Errorf("Iterate() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+	if _, err := Unfold[int, int](0, nil); err != ErrNilSelector {
+		t.Errorf("Unfold() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+}
@@ -4,4 +4,7 @@ go 1.18
 
 retract [v0.1.0, v0.16.0]
 
-require golang.org/x/sync v0.0.0-20210220032951-036812b2e83c // indirect
+require (
+	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c
+	golang.org/x/text v0.14.0
+)
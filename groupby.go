@@ -21,7 +21,10 @@ func GroupBySelEq[Source, Key, Element any](source Enumerator[Source],
 	if equaler == nil {
 		equaler = EqualerFunc[Key](DeepEqual[Key])
 	}
-	lk := ToLookupSelEqMust(source, keySelector, elementSelector, equaler)
+	lk, err := ToLookupSelEq(source, keySelector, elementSelector, equaler)
+	if err != nil {
+		return nil, err
+	}
 	return lk.GetEnumerator(), nil
 }
 
@@ -48,7 +51,10 @@ func GroupBySelResEq[Source, Key, Element, Result any](source Enumerator[Source]
 	if keySelector == nil || elementSelector == nil || resultSelector == nil {
 		return nil, ErrNilSelector
 	}
-	grgr := GroupBySelEqMust(source, keySelector, elementSelector, equaler)
+	grgr, err := GroupBySelEq(source, keySelector, elementSelector, equaler)
+	if err != nil {
+		return nil, err
+	}
 	return Select(grgr, func(gr Grouping[Key, Element]) Result {
 		return resultSelector(gr.key, gr.GetEnumerator())
 	})
@@ -206,3 +212,111 @@ func GroupByEqMust[Source, Key any](source Enumerator[Source],
 	}
 	return r
 }
+
+// GroupBySelHash groups the elements of a sequence according to a key selector function.
+// The keys are compared using a Hasher
+// and each group's elements are projected using a specified function. 'source' is enumerated immediately.
+func GroupBySelHash[Source, Key, Element any](source Enumerator[Source],
+	keySelector func(Source) Key, elementSelector func(Source) Element, hasher Hasher[Key]) (Enumerator[Grouping[Key, Element]], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil || elementSelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	lk, err := ToLookupSelHash(source, keySelector, elementSelector, hasher)
+	if err != nil {
+		return nil, err
+	}
+	return lk.GetEnumerator(), nil
+}
+
+// GroupBySelHashMust is like GroupBySelHash but panics in case of error.
+func GroupBySelHashMust[Source, Key, Element any](source Enumerator[Source],
+	keySelector func(Source) Key, elementSelector func(Source) Element, hasher Hasher[Key]) Enumerator[Grouping[Key, Element]] {
+	r, err := GroupBySelHash(source, keySelector, elementSelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// GroupByHash groups the elements of a sequence according to a specified key selector function
+// and compares the keys using a specified Hasher. 'source' is enumerated immediately.
+func GroupByHash[Source, Key any](source Enumerator[Source],
+	keySelector func(Source) Key, hasher Hasher[Key]) (Enumerator[Grouping[Key, Source]], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return GroupBySelHash(source, keySelector, Identity[Source], hasher)
+}
+
+// GroupByHashMust is like GroupByHash but panics in case of error.
+func GroupByHashMust[Source, Key any](source Enumerator[Source],
+	keySelector func(Source) Key, hasher Hasher[Key]) Enumerator[Grouping[Key, Source]] {
+	r, err := GroupByHash(source, keySelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// GroupBySelComparable groups the elements of a sequence according to a key selector function
+// and projects the elements for each group using a specified function.
+// The keys are compared using ==. 'source' is enumerated immediately.
+func GroupBySelComparable[Source any, Key comparable, Element any](source Enumerator[Source],
+	keySelector func(Source) Key, elementSelector func(Source) Element) (Enumerator[Grouping[Key, Element]], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil || elementSelector == nil {
+		return nil, ErrNilSelector
+	}
+	lk, err := ToLookupSelComparable(source, keySelector, elementSelector)
+	if err != nil {
+		return nil, err
+	}
+	return lk.GetEnumerator(), nil
+}
+
+// GroupBySelComparableMust is like GroupBySelComparable but panics in case of error.
+func GroupBySelComparableMust[Source any, Key comparable, Element any](source Enumerator[Source],
+	keySelector func(Source) Key, elementSelector func(Source) Element) Enumerator[Grouping[Key, Element]] {
+	r, err := GroupBySelComparable(source, keySelector, elementSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// GroupByComparable groups the elements of a sequence according to a specified key selector function.
+// The keys are compared using ==. 'source' is enumerated immediately.
+func GroupByComparable[Source any, Key comparable](source Enumerator[Source],
+	keySelector func(Source) Key) (Enumerator[Grouping[Key, Source]], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	return GroupBySelComparable(source, keySelector, Identity[Source])
+}
+
+// GroupByComparableMust is like GroupByComparable but panics in case of error.
+func GroupByComparableMust[Source any, Key comparable](source Enumerator[Source],
+	keySelector func(Source) Key) Enumerator[Grouping[Key, Source]] {
+	r, err := GroupByComparable(source, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -148,3 +148,25 @@ func Test_GroupBySelRes(t *testing.T) {
 		t.Errorf("GroupBySelRes = '%v', want '%v'", String(got), String(want))
 	}
 }
+
+func Test_GroupByComparable(t *testing.T) {
+	en := NewOnSliceEn("abc", "hello", "def", "there", "four")
+	got := GroupBySelComparableMust(en, func(el string) int { return len(el) }, func(el string) string { return el[:1] })
+	want := NewOnSliceEn("3: [a d]", "5: [h t]", "4: [f]")
+	if !SequenceEqualMust(SelectMust(got, func(gr Grouping[int, string]) string { return gr.String() }), want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("GroupBySelComparable = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_GroupByHash(t *testing.T) {
+	en := NewOnSliceEn("abc", "ABC", "def", "Abc", "DEF")
+	got := GroupByHashMust(en, Identity[string], CaseInsensitiveHasher)
+	want := NewOnSliceEn("abc: [abc ABC Abc]", "def: [def DEF]")
+	if !SequenceEqualMust(SelectMust(got, func(gr Grouping[string, string]) string { return gr.String() }), want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("GroupByHash = '%v', want '%v'", String(got), String(want))
+	}
+}
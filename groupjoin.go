@@ -29,9 +29,16 @@ func GroupJoinEq[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner E
 	}
 	var once sync.Once
 	var ilk *Lookup[Key, Inner]
+	var ierr error
 	return OnFunc[Result]{
 			mvNxt: func() bool {
-				once.Do(func() { ilk = ToLookupEqMust(inner, innerKeySelector, equaler) })
+				once.Do(func() {
+					ilk, ierr = ToLookupEq(inner, innerKeySelector, equaler)
+					Close(inner)
+				})
+				if ierr != nil {
+					return false
+				}
 				return outer.MoveNext()
 			},
 			crrnt: func() Result {
@@ -39,6 +46,8 @@ func GroupJoinEq[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner E
 				return resultSelector(c, ilk.Item(outerKeySelector(c)))
 			},
 			rst: func() { outer.Reset() },
+			err: func() error { return firstErr(ierr, Err(outer)) },
+			cls: func() error { return firstErr(Close(outer), Close(inner)) },
 		},
 		nil
 }
@@ -67,6 +76,9 @@ func GroupJoinEqSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inn
 		return nil, ErrNilSelector
 	}
 	isl := Slice(inner)
+	if err := Err(inner); err != nil {
+		return nil, err
+	}
 	outer.Reset()
 	return GroupJoinEq(outer, NewOnSliceEn(isl...), outerKeySelector, innerKeySelector, resultSelector, equaler)
 }
@@ -118,6 +130,9 @@ func GroupJoinSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner
 		return nil, ErrNilSelector
 	}
 	isl := Slice(inner)
+	if err := Err(inner); err != nil {
+		return nil, err
+	}
 	outer.Reset()
 	return GroupJoin(outer, NewOnSliceEn(isl...), outerKeySelector, innerKeySelector, resultSelector)
 }
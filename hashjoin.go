@@ -0,0 +1,192 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"sync"
+)
+
+// https://en.wikipedia.org/wiki/Hash_join
+
+// hashJoin correlates the elements of two sequences based on matching keys using a hash table.
+// The hash table is built on 'inner', unless both sequences implement Counter and 'outer' has fewer elements.
+func hashJoin[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner],
+	outerKeySelector func(Outer) Key, innerKeySelector func(Inner) Key, resultSelector func(Outer, Inner) Result,
+	newOuterLookup func() *Lookup[Key, Outer], newInnerLookup func() *Lookup[Key, Inner]) Enumerator[Result] {
+	var once sync.Once
+	// buildOuter reports whether the hash table is built on 'outer'
+	var buildOuter bool
+	var olk *Lookup[Key, Outer]
+	var ilk *Lookup[Key, Inner]
+	var berr error
+	var oel Outer
+	var iel Inner
+	// oo, ii - the not yet enumerated elements matching the current streamed element
+	var oo []Outer
+	var ii []Inner
+	return OnFunc[Result]{
+		mvNxt: func() bool {
+			once.Do(func() {
+				if oc, ok := outer.(Counter); ok {
+					if ic, ok := inner.(Counter); ok {
+						buildOuter = oc.Count() < ic.Count()
+					}
+				}
+				if buildOuter {
+					olk = newOuterLookup()
+					for outer.MoveNext() {
+						o := outer.Current()
+						olk.add(outerKeySelector(o), o)
+					}
+					berr = Err(outer)
+					Close(outer)
+					return
+				}
+				ilk = newInnerLookup()
+				for inner.MoveNext() {
+					i := inner.Current()
+					ilk.add(innerKeySelector(i), i)
+				}
+				berr = Err(inner)
+				Close(inner)
+			})
+			if berr != nil {
+				return false
+			}
+			if buildOuter {
+				for {
+					if len(oo) > 0 {
+						oel = oo[0]
+						oo = oo[1:]
+						return true
+					}
+					if !inner.MoveNext() {
+						return false
+					}
+					iel = inner.Current()
+					oo = olk.ItemSlice(innerKeySelector(iel))
+				}
+			}
+			for {
+				if len(ii) > 0 {
+					iel = ii[0]
+					ii = ii[1:]
+					return true
+				}
+				if !outer.MoveNext() {
+					return false
+				}
+				oel = outer.Current()
+				ii = ilk.ItemSlice(outerKeySelector(oel))
+			}
+		},
+		crrnt: func() Result { return resultSelector(oel, iel) },
+		rst: func() {
+			oo, ii = nil, nil
+			if buildOuter {
+				inner.Reset()
+			} else {
+				outer.Reset()
+			}
+		},
+		err: func() error { return firstErr(berr, Err(outer), Err(inner)) },
+		cls: func() error { return firstErr(Close(outer), Close(inner)) },
+	}
+}
+
+// JoinHash correlates the elements of two sequences based on matching keys.
+// A specified Hasher is used to compare keys, so the keys are matched in O(1) on average.
+// 'inner' is enumerated immediately and its elements are put into a hash table.
+//
+// If both 'outer' and 'inner' implement Counter (see TryGetNonEnumeratedCount) and 'outer' has fewer elements,
+// the hash table is built on 'outer' instead, and 'inner' is streamed.
+// In this case the order of elements in the result corresponds to the order of elements in 'inner'
+// (and then to the order of the matching elements in 'outer').
+//
+// 'outer' and 'inner' must not be based on the same Enumerator, otherwise use JoinHashSelf instead.
+func JoinHash[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, Inner) Result, hasher Hasher[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return hashJoin(outer, inner, outerKeySelector, innerKeySelector, resultSelector,
+			func() *Lookup[Key, Outer] { return newLookupHash[Key, Outer](hasher) },
+			func() *Lookup[Key, Inner] { return newLookupHash[Key, Inner](hasher) }),
+		nil
+}
+
+// JoinHashMust is like JoinHash but panics in case of error.
+func JoinHashMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, Inner) Result, hasher Hasher[Key]) Enumerator[Result] {
+	r, err := JoinHash(outer, inner, outerKeySelector, innerKeySelector, resultSelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// JoinHashSelf correlates the elements of two sequences based on matching keys.
+// A specified Hasher is used to compare keys. 'inner' is enumerated immediately.
+// 'outer' and 'inner' may be based on the same Enumerator.
+// 'outer' must have real Reset method.
+func JoinHashSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, Inner) Result, hasher Hasher[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	isl := Slice(inner)
+	if err := Err(inner); err != nil {
+		return nil, err
+	}
+	outer.Reset()
+	return JoinHash(outer, NewOnSliceEn(isl...), outerKeySelector, innerKeySelector, resultSelector, hasher)
+}
+
+// JoinHashSelfMust is like JoinHashSelf but panics in case of error.
+func JoinHashSelfMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, Inner) Result, hasher Hasher[Key]) Enumerator[Result] {
+	r, err := JoinHashSelf(outer, inner, outerKeySelector, innerKeySelector, resultSelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// JoinComparable correlates the elements of two sequences based on matching comparable keys.
+// == is used to compare keys, the keys are matched with the help of a map.
+// 'inner' is enumerated immediately (or 'outer', see JoinHash).
+// 'outer' and 'inner' must not be based on the same Enumerator.
+func JoinComparable[Outer, Inner any, Key comparable, Result any](outer Enumerator[Outer], inner Enumerator[Inner],
+	outerKeySelector func(Outer) Key, innerKeySelector func(Inner) Key, resultSelector func(Outer, Inner) Result) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return hashJoin(outer, inner, outerKeySelector, innerKeySelector, resultSelector,
+			newLookupComparable[Key, Outer], newLookupComparable[Key, Inner]),
+		nil
+}
+
+// JoinComparableMust is like JoinComparable but panics in case of error.
+func JoinComparableMust[Outer, Inner any, Key comparable, Result any](outer Enumerator[Outer], inner Enumerator[Inner],
+	outerKeySelector func(Outer) Key, innerKeySelector func(Inner) Key, resultSelector func(Outer, Inner) Result) Enumerator[Result] {
+	r, err := JoinComparable(outer, inner, outerKeySelector, innerKeySelector, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,134 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+// noCounter hides the Counter implementation of the embedded Enumerator
+type noCounter[T any] struct {
+	Enumerator[T]
+}
+
+func Test_JoinHashMust(t *testing.T) {
+	type args struct {
+		outer Enumerator[string]
+		inner Enumerator[string]
+	}
+	tests := []struct {
+		name string
+		args args
+		want Enumerator[string]
+	}{
+		{name: "BuildOnInner",
+			args: args{
+				outer: NewOnSlice("first", "second", "third", "fourth"),
+				inner: NewOnSlice("essence", "offer", "eating", "psalm"),
+			},
+			want: NewOnSlice("first:offer", "second:essence", "second:psalm", "fourth:offer"),
+		},
+		{name: "BuildOnOuter",
+			args: args{
+				outer: NewOnSlice("first", "second"),
+				inner: NewOnSlice("essence", "offer", "eating", "psalm"),
+			},
+			// ordered by inner
+			want: NewOnSlice("second:essence", "first:offer", "second:psalm"),
+		},
+		{name: "OuterNotCounter",
+			args: args{
+				outer: noCounter[string]{NewOnSlice("first", "second")},
+				inner: NewOnSlice("essence", "offer", "eating", "psalm"),
+			},
+			want: NewOnSlice("first:offer", "second:essence", "second:psalm"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := JoinHashMust(tt.args.outer, tt.args.inner,
+				func(oel string) rune { return ([]rune(oel))[0] },
+				func(iel string) rune { return ([]rune(iel))[1] },
+				func(oel, iel string) string { return oel + ":" + iel },
+				Order[rune]{},
+			)
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("JoinHash() = '%v', want '%v'", String(got), String(tt.want))
+			}
+			got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(got, tt.want) {
+				t.Errorf("JoinHash() after Reset differs")
+			}
+		})
+	}
+}
+
+func Test_JoinHash_CaseInsensitive(t *testing.T) {
+	got := JoinHashMust(
+		NewOnSlice("ABCxxx", "abcyyy", "defzzz", "ghizzz"),
+		NewOnSlice("000abc", "111gHi", "222333"),
+		func(oel string) string { return oel[:3] },
+		func(iel string) string { return iel[3:] },
+		func(oel, iel string) string { return oel + ":" + iel },
+		OrdinalIgnoreCaseHasher,
+	)
+	want := NewOnSlice("ABCxxx:000abc", "abcyyy:000abc", "ghizzz:111gHi")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("JoinHash() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_JoinHashSelfMust(t *testing.T) {
+	source := NewOnSlice("fs", "sf", "ff", "xy")
+	got := JoinHashSelfMust(source, source,
+		func(oel string) byte { return oel[0] },
+		func(iel string) byte { return iel[1] },
+		func(oel, iel string) string { return oel + ":" + iel },
+		Order[byte]{},
+	)
+	want := NewOnSlice("fs:sf", "fs:ff", "sf:fs", "ff:sf", "ff:ff")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("JoinHashSelf() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_JoinComparableMust(t *testing.T) {
+	type person struct {
+		name string
+		city string
+	}
+	got := JoinComparableMust(
+		NewOnSlice(person{"Ann", "Oslo"}, person{"Bob", "Rome"}, person{"Cid", "Oslo"}, person{"Dan", "Bern"}),
+		NewOnSlice("Oslo:NO", "Rome:IT", "Oslo:Norway"),
+		func(p person) string { return p.city },
+		func(c string) string { return c[:4] },
+		func(p person, c string) string { return p.name + "@" + c[5:] },
+	)
+	want := NewOnSlice("Ann@NO", "Ann@Norway", "Bob@IT", "Cid@NO", "Cid@Norway")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("JoinComparable() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_JoinHash_errors(t *testing.T) {
+	rs := func(oel, iel int) int { return oel }
+	if _, err := JoinHash(NewOnSliceEn(1), nil, Identity[int], Identity[int], rs, Order[int]{}); err != ErrNilSource {
+		t.Errorf("JoinHash() error = '%v', want '%v'", err, ErrNilSource)
+	}
+	if _, err := JoinHash[int, int, int, int](NewOnSliceEn(1), NewOnSliceEn(1), Identity[int], Identity[int], rs, nil); err != ErrNilHasher {
+		t.Errorf("JoinHash() error = '%v', want '%v'", err, ErrNilHasher)
+	}
+	got := JoinComparableMust[int, int, int, int](NewOnSliceEn(1), failAt(NewOnSliceEn(1, 2), 2), Identity[int], Identity[int], rs)
+	if _, err := SliceErr(got); err != errTest {
+		t.Errorf("JoinComparable() error = '%v', want '%v'", err, errTest)
+	}
+}
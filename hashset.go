@@ -0,0 +1,104 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"constraints"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+// hashSeed is a seed for strings hashing
+var hashSeed = maphash.MakeSeed()
+
+// hashUint64 mixes the bits of 'x' (see https://xorshift.di.unimi.it/splitmix64.c)
+func hashUint64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// hashFloat64 hashes 'x' so that +0 and -0 have the same hash code
+func hashFloat64(x float64) uint64 {
+	if x == 0 {
+		return hashUint64(0)
+	}
+	return hashUint64(math.Float64bits(x))
+}
+
+// hashString hashes 's' using hash/maphash
+func hashString(s string) uint64 {
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+	h.WriteString(s)
+	return h.Sum64()
+}
+
+// hashOrdered hashes a value of an ordered type
+func hashOrdered[T constraints.Ordered](x T) uint64 {
+	switch v := any(x).(type) {
+	case string:
+		return hashString(v)
+	case int:
+		return hashUint64(uint64(v))
+	case int64:
+		return hashUint64(uint64(v))
+	case int32:
+		return hashUint64(uint64(v))
+	case uint:
+		return hashUint64(uint64(v))
+	case uint64:
+		return hashUint64(v)
+	case uint32:
+		return hashUint64(uint64(v))
+	case float64:
+		return hashFloat64(v)
+	case float32:
+		return hashFloat64(float64(v))
+	}
+	// other basic types and types with ordered underlying types
+	rv := reflect.ValueOf(x)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return hashUint64(uint64(rv.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return hashUint64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return hashFloat64(rv.Float())
+	case reflect.String:
+		return hashString(rv.String())
+	}
+	return 0
+}
+
+// hashSet is a set of elements based on a Hasher
+type hashSet[T any] struct {
+	hasher  Hasher[T]
+	buckets map[uint64][]T
+}
+
+// newHashSet creates new empty hashSet with the provided Hasher
+func newHashSet[T any](hasher Hasher[T]) *hashSet[T] {
+	return &hashSet[T]{hasher: hasher, buckets: make(map[uint64][]T)}
+}
+
+// contains determines whether 'hs' contains 'el'
+func (hs *hashSet[T]) contains(el T) bool {
+	return elInElelEq(el, hs.buckets[hs.hasher.Hash(el)], hs.hasher)
+}
+
+// add adds 'el' to 'hs'
+// add returns false if 'hs' already contains 'el'
+func (hs *hashSet[T]) add(el T) bool {
+	h := hs.hasher.Hash(el)
+	b := hs.buckets[h]
+	if elInElelEq(el, b, hs.hasher) {
+		return false
+	}
+	hs.buckets[h] = append(b, el)
+	return true
+}
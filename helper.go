@@ -26,6 +26,16 @@ func catchErrStr[T any](panicArg any, res *T, err *error) {
 	panic(panicArg)
 }
 
+// firstErr returns the first non-nil error from 'errs'
+func firstErr(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // elInElelEq determines (using 'equaler') whether 'ee' contains 'el'
 func elInElelEq[T any](el T, ee []T, equaler Equaler[T]) bool {
 	for _, e := range ee {
@@ -2,6 +2,10 @@
 
 package go2linq
 
+import (
+	"errors"
+)
+
 type (
 	elel[T any] struct {
 		e1, e2 T
@@ -27,3 +31,31 @@ func panickingEnumerator[T any]() Enumerator[T] {
 		rst: func() {},
 	}
 }
+
+var errTest = errors.New("test error")
+
+// failAt returns an ErrEnumerator that fails with errTest on the element equal to 'at'.
+func failAt(source Enumerator[int], at int) ErrEnumerator[int] {
+	return SelectErrMust(source, func(i int) (int, error) {
+		if i == at {
+			return 0, errTest
+		}
+		return i, nil
+	})
+}
+
+// closeCounter is an Enumerator (but not a Counter) that counts calls to its Close method.
+type closeCounter struct {
+	Enumerator[int]
+	closed int
+}
+
+func newCloseCounter(ee ...int) *closeCounter {
+	return &closeCounter{Enumerator: NewOnSlice(ee...)}
+}
+
+// Close implements the io.Closer interface.
+func (cc *closeCounter) Close() error {
+	cc.closed++
+	return nil
+}
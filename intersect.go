@@ -39,6 +39,9 @@ func IntersectSelf[Source any](first, second Enumerator[Source]) (Enumerator[Sou
 		return nil, ErrNilSource
 	}
 	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
 	first.Reset()
 	return Intersect(first, NewOnSliceEn(sl2...))
 }
@@ -71,6 +74,9 @@ func IntersectEq[Source any](first, second Enumerator[Source], equaler Equaler[S
 	return OnFunc[Source]{
 			mvNxt: func() bool {
 				once.Do(func() { dsl2 = Slice(DistinctEqMust(second, equaler)) })
+				if Err(second) != nil {
+					return false
+				}
 				for d1.MoveNext() {
 					c = d1.Current()
 					if elInElelEq(c, dsl2, equaler) {
@@ -81,6 +87,8 @@ func IntersectEq[Source any](first, second Enumerator[Source], equaler Equaler[S
 			},
 			crrnt: func() Source { return c },
 			rst:   func() { d1.Reset() },
+			err:   func() error { return firstErr(Err(d1), Err(second)) },
+			cls:   func() error { return firstErr(Close(d1), Close(second)) },
 		},
 		nil
 }
@@ -105,6 +113,9 @@ func IntersectEqSelf[Source any](first, second Enumerator[Source], equaler Equal
 		return nil, ErrNilSource
 	}
 	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
 	first.Reset()
 	return IntersectEq(first, NewOnSliceEn(sl2...), equaler)
 }
@@ -140,6 +151,9 @@ func IntersectCmp[Source any](first, second Enumerator[Source], comparer Compare
 					dsl2 = Slice(DistinctCmpMust(second, comparer))
 					sort.Slice(dsl2, func(i, j int) bool { return comparer.Compare(dsl2[i], dsl2[j]) < 0 })
 				})
+				if Err(second) != nil {
+					return false
+				}
 				for d1.MoveNext() {
 					c = d1.Current()
 					if elInElelCmp(c, dsl2, comparer) {
@@ -150,6 +164,8 @@ func IntersectCmp[Source any](first, second Enumerator[Source], comparer Compare
 			},
 			crrnt: func() Source { return c },
 			rst:   func() { d1.Reset() },
+			err:   func() error { return firstErr(Err(d1), Err(second)) },
+			cls:   func() error { return firstErr(Close(d1), Close(second)) },
 		},
 		nil
 }
@@ -177,6 +193,9 @@ func IntersectCmpSelf[Source any](first, second Enumerator[Source], comparer Com
 		return nil, ErrNilComparer
 	}
 	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
 	first.Reset()
 	return IntersectCmp(first, NewOnSliceEn(sl2...), comparer)
 }
@@ -189,3 +208,85 @@ func IntersectCmpSelfMust[Source any](first, second Enumerator[Source], comparer
 	}
 	return r
 }
+
+// IntersectHash produces the set intersection of two sequences using the specified Hasher to compare values.
+// (See DistinctHash function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IntersectHashSelf instead.
+func IntersectHash[Source any](first, second Enumerator[Source], hasher Hasher[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	var once sync.Once
+	var hs2 *hashSet[Source]
+	d1 := DistinctHashMust(first, hasher)
+	var c Source
+	return OnFunc[Source]{
+			mvNxt: func() bool {
+				once.Do(func() {
+					hs2 = newHashSet(hasher)
+					for second.MoveNext() {
+						hs2.add(second.Current())
+					}
+				})
+				if Err(second) != nil {
+					return false
+				}
+				for d1.MoveNext() {
+					c = d1.Current()
+					if hs2.contains(c) {
+						return true
+					}
+				}
+				return false
+			},
+			crrnt: func() Source { return c },
+			rst:   func() { d1.Reset() },
+			err:   func() error { return firstErr(Err(d1), Err(second)) },
+			cls:   func() error { return firstErr(Close(d1), Close(second)) },
+		},
+		nil
+}
+
+// IntersectHashMust is like IntersectHash but panics in case of error.
+func IntersectHashMust[Source any](first, second Enumerator[Source], hasher Hasher[Source]) Enumerator[Source] {
+	r, err := IntersectHash(first, second, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectHashSelf produces the set intersection of two sequences using the specified Hasher to compare values.
+// (See DistinctHash function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IntersectHashSelf[Source any](first, second Enumerator[Source], hasher Hasher[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return IntersectHash(first, NewOnSliceEn(sl2...), hasher)
+}
+
+// IntersectHashSelfMust is like IntersectHashSelf but panics in case of error.
+func IntersectHashSelfMust[Source any](first, second Enumerator[Source], hasher Hasher[Source]) Enumerator[Source] {
+	r, err := IntersectHashSelf(first, second, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,459 @@
+//go:build go1.18
+
+package go2linq
+
+// https://www.postgresql.org/docs/current/queries-union.html
+
+// IntersectAll produces the multiset intersection of two sequences
+// using reflect.DeepEqual to compare values.
+// Each element of 'first' is returned if there is a not yet matched equal element in 'second'
+// (so an element occurs in the result min(m, n) times, where m and n are its numbers of occurrences in the sequences),
+// as SQL INTERSECT ALL does.
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IntersectAllSelf instead.
+func IntersectAll[Source any](first, second Enumerator[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	return bagOp(first, second, Identity[Source], lookupEqFactory[Source](nil), bagIntersect), nil
+}
+
+// IntersectAllMust is like IntersectAll but panics in case of error.
+func IntersectAllMust[Source any](first, second Enumerator[Source]) Enumerator[Source] {
+	r, err := IntersectAll(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllSelf produces the multiset intersection of two sequences
+// using reflect.DeepEqual to compare values.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IntersectAllSelf[Source any](first, second Enumerator[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return IntersectAll(first, NewOnSliceEn(sl2...))
+}
+
+// IntersectAllSelfMust is like IntersectAllSelf but panics in case of error.
+func IntersectAllSelfMust[Source any](first, second Enumerator[Source]) Enumerator[Source] {
+	r, err := IntersectAllSelf(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllEq produces the multiset intersection of two sequences
+// using the specified Equaler to compare values.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IntersectAllEqSelf instead.
+func IntersectAllEq[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	return bagOp(first, second, Identity[Source], lookupEqFactory(equaler), bagIntersect), nil
+}
+
+// IntersectAllEqMust is like IntersectAllEq but panics in case of error.
+func IntersectAllEqMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) Enumerator[Source] {
+	r, err := IntersectAllEq(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllEqSelf produces the multiset intersection of two sequences
+// using the specified Equaler to compare values.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IntersectAllEqSelf[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return IntersectAllEq(first, NewOnSliceEn(sl2...), equaler)
+}
+
+// IntersectAllEqSelfMust is like IntersectAllEqSelf but panics in case of error.
+func IntersectAllEqSelfMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) Enumerator[Source] {
+	r, err := IntersectAllEqSelf(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllCmp produces the multiset intersection of two sequences
+// using the specified Comparer to compare values.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IntersectAllCmpSelf instead.
+func IntersectAllCmp[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return bagOp(first, second, Identity[Source], lookupCmpFactory(comparer), bagIntersect), nil
+}
+
+// IntersectAllCmpMust is like IntersectAllCmp but panics in case of error.
+func IntersectAllCmpMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) Enumerator[Source] {
+	r, err := IntersectAllCmp(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllCmpSelf produces the multiset intersection of two sequences
+// using the specified Comparer to compare values.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IntersectAllCmpSelf[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return IntersectAllCmp(first, NewOnSliceEn(sl2...), comparer)
+}
+
+// IntersectAllCmpSelfMust is like IntersectAllCmpSelf but panics in case of error.
+func IntersectAllCmpSelfMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) Enumerator[Source] {
+	r, err := IntersectAllCmpSelf(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllHash produces the multiset intersection of two sequences
+// using the specified Hasher to compare values.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IntersectAllHashSelf instead.
+func IntersectAllHash[Source any](first, second Enumerator[Source], hasher Hasher[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return bagOp(first, second, Identity[Source], lookupHashFactory(hasher), bagIntersect), nil
+}
+
+// IntersectAllHashMust is like IntersectAllHash but panics in case of error.
+func IntersectAllHashMust[Source any](first, second Enumerator[Source], hasher Hasher[Source]) Enumerator[Source] {
+	r, err := IntersectAllHash(first, second, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllHashSelf produces the multiset intersection of two sequences
+// using the specified Hasher to compare values.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IntersectAllHashSelf[Source any](first, second Enumerator[Source], hasher Hasher[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return IntersectAllHash(first, NewOnSliceEn(sl2...), hasher)
+}
+
+// IntersectAllHashSelfMust is like IntersectAllHashSelf but panics in case of error.
+func IntersectAllHashSelfMust[Source any](first, second Enumerator[Source], hasher Hasher[Source]) Enumerator[Source] {
+	r, err := IntersectAllHashSelf(first, second, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllBy produces the multiset intersection of two sequences according to a specified key selector function
+// using reflect.DeepEqual to compare keys.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IntersectAllBySelf instead.
+func IntersectAllBy[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	return bagOp(first, second, keySelector, lookupEqFactory[Key](nil), bagIntersect), nil
+}
+
+// IntersectAllByMust is like IntersectAllBy but panics in case of error.
+func IntersectAllByMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) Enumerator[Source] {
+	r, err := IntersectAllBy(first, second, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllBySelf produces the multiset intersection of two sequences according to a specified key selector function
+// using reflect.DeepEqual to compare keys.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IntersectAllBySelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return IntersectAllBy(first, NewOnSliceEn(sl2...), keySelector)
+}
+
+// IntersectAllBySelfMust is like IntersectAllBySelf but panics in case of error.
+func IntersectAllBySelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) Enumerator[Source] {
+	r, err := IntersectAllBySelf(first, second, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllByEq produces the multiset intersection of two sequences according to a specified key selector function
+// using the specified Equaler to compare keys.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IntersectAllByEqSelf instead.
+func IntersectAllByEq[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	return bagOp(first, second, keySelector, lookupEqFactory(equaler), bagIntersect), nil
+}
+
+// IntersectAllByEqMust is like IntersectAllByEq but panics in case of error.
+func IntersectAllByEqMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) Enumerator[Source] {
+	r, err := IntersectAllByEq(first, second, keySelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllByEqSelf produces the multiset intersection of two sequences according to a specified key selector function
+// using the specified Equaler to compare keys.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IntersectAllByEqSelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return IntersectAllByEq(first, NewOnSliceEn(sl2...), keySelector, equaler)
+}
+
+// IntersectAllByEqSelfMust is like IntersectAllByEqSelf but panics in case of error.
+func IntersectAllByEqSelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) Enumerator[Source] {
+	r, err := IntersectAllByEqSelf(first, second, keySelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllByCmp produces the multiset intersection of two sequences according to a specified key selector function
+// using the specified Comparer to compare keys.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IntersectAllByCmpSelf instead.
+func IntersectAllByCmp[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return bagOp(first, second, keySelector, lookupCmpFactory(comparer), bagIntersect), nil
+}
+
+// IntersectAllByCmpMust is like IntersectAllByCmp but panics in case of error.
+func IntersectAllByCmpMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) Enumerator[Source] {
+	r, err := IntersectAllByCmp(first, second, keySelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllByCmpSelf produces the multiset intersection of two sequences according to a specified key selector function
+// using the specified Comparer to compare keys.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IntersectAllByCmpSelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return IntersectAllByCmp(first, NewOnSliceEn(sl2...), keySelector, comparer)
+}
+
+// IntersectAllByCmpSelfMust is like IntersectAllByCmpSelf but panics in case of error.
+func IntersectAllByCmpSelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) Enumerator[Source] {
+	r, err := IntersectAllByCmpSelf(first, second, keySelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllByHash produces the multiset intersection of two sequences according to a specified key selector function
+// using the specified Hasher to compare keys.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IntersectAllByHashSelf instead.
+func IntersectAllByHash[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return bagOp(first, second, keySelector, lookupHashFactory(hasher), bagIntersect), nil
+}
+
+// IntersectAllByHashMust is like IntersectAllByHash but panics in case of error.
+func IntersectAllByHashMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) Enumerator[Source] {
+	r, err := IntersectAllByHash(first, second, keySelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IntersectAllByHashSelf produces the multiset intersection of two sequences according to a specified key selector function
+// using the specified Hasher to compare keys.
+// (See IntersectAll function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IntersectAllByHashSelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return IntersectAllByHash(first, NewOnSliceEn(sl2...), keySelector, hasher)
+}
+
+// IntersectAllByHashSelfMust is like IntersectAllByHashSelf but panics in case of error.
+func IntersectAllByHashSelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) Enumerator[Source] {
+	r, err := IntersectAllByHashSelf(first, second, keySelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,57 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_IntersectAllMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  Enumerator[int]
+		second Enumerator[int]
+		want   Enumerator[int]
+	}{
+		{name: "EmptySecond", first: NewOnSlice(1, 2), second: Empty[int](), want: Empty[int]()},
+		{name: "Multiplicities", first: NewOnSlice(1, 2, 1, 3, 1), second: NewOnSlice(3, 1, 1, 4), want: NewOnSlice(1, 1, 3)},
+		{name: "FirstFewer", first: NewOnSlice(2, 1), second: NewOnSlice(1, 1, 1, 2, 2), want: NewOnSlice(2, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IntersectAllMust(tt.first, tt.second)
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("IntersectAll() = '%v', want '%v'", String(got), String(tt.want))
+			}
+			got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(got, tt.want) {
+				t.Errorf("IntersectAll() after Reset differs")
+			}
+		})
+	}
+}
+
+func Test_IntersectAllByHashMust(t *testing.T) {
+	got := IntersectAllByHashMust(NewOnSliceEn("one", "two", "three", "four", "six"), NewOnSliceEn("ten", "eleven", "nine"),
+		func(s string) int { return len(s) }, NewHasher(func(i int) uint64 { return uint64(i) }, func(x, y int) bool { return x == y }))
+	want := NewOnSliceEn("one", "four")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("IntersectAllByHash() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_IntersectAllSelfMust(t *testing.T) {
+	e := NewOnSliceEn(1, 2, 2, 3)
+	got := IntersectAllSelfMust(e, SkipMust(e, 2))
+	want := NewOnSliceEn(2, 3)
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("IntersectAllSelf() = '%v', want '%v'", String(got), String(want))
+	}
+}
@@ -0,0 +1,321 @@
+//go:build go1.18
+
+package go2linq
+
+// https://docs.microsoft.com/dotnet/api/system.collections.generic.iset-1
+
+// IsSubsetOf determines whether each element of 'first' is contained in 'second' using reflect.DeepEqual to compare elements.
+// Order and duplicates of elements are ignored, an empty sequence is a subset of any sequence.
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IsSubsetOfSelf instead.
+func IsSubsetOf[Source any](first, second Enumerator[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	r, err := relateSets(first, second, lookupEqFactory[Source](nil), false)
+	if err != nil {
+		return false, err
+	}
+	return !r.firstOnly, nil
+}
+
+// IsSubsetOfMust is like IsSubsetOf but panics in case of error.
+func IsSubsetOfMust[Source any](first, second Enumerator[Source]) bool {
+	r, err := IsSubsetOf(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsSubsetOfSelf determines whether each element of 'first' is contained in 'second' using reflect.DeepEqual to compare elements.
+// (See IsSubsetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IsSubsetOfSelf[Source any](first, second Enumerator[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return IsSubsetOf(first, NewOnSliceEn(sl2...))
+}
+
+// IsSubsetOfSelfMust is like IsSubsetOfSelf but panics in case of error.
+func IsSubsetOfSelfMust[Source any](first, second Enumerator[Source]) bool {
+	r, err := IsSubsetOfSelf(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsSubsetOfEq determines whether each element of 'first' is contained in 'second' using a specified Equaler to compare elements.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See IsSubsetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IsSubsetOfEqSelf instead.
+func IsSubsetOfEq[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	r, err := relateSets(first, second, lookupEqFactory(equaler), false)
+	if err != nil {
+		return false, err
+	}
+	return !r.firstOnly, nil
+}
+
+// IsSubsetOfEqMust is like IsSubsetOfEq but panics in case of error.
+func IsSubsetOfEqMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) bool {
+	r, err := IsSubsetOfEq(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsSubsetOfEqSelf determines whether each element of 'first' is contained in 'second' using a specified Equaler to compare elements.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See IsSubsetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IsSubsetOfEqSelf[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return IsSubsetOfEq(first, NewOnSliceEn(sl2...), equaler)
+}
+
+// IsSubsetOfEqSelfMust is like IsSubsetOfEqSelf but panics in case of error.
+func IsSubsetOfEqSelfMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) bool {
+	r, err := IsSubsetOfEqSelf(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsSubsetOfCmp determines whether each element of 'first' is contained in 'second' using a specified Comparer to compare elements.
+// (See IsSubsetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IsSubsetOfCmpSelf instead.
+func IsSubsetOfCmp[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	if comparer == nil {
+		return false, ErrNilComparer
+	}
+	r, err := relateSets(first, second, lookupCmpFactory(comparer), false)
+	if err != nil {
+		return false, err
+	}
+	return !r.firstOnly, nil
+}
+
+// IsSubsetOfCmpMust is like IsSubsetOfCmp but panics in case of error.
+func IsSubsetOfCmpMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) bool {
+	r, err := IsSubsetOfCmp(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsSubsetOfCmpSelf determines whether each element of 'first' is contained in 'second' using a specified Comparer to compare elements.
+// (See IsSubsetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IsSubsetOfCmpSelf[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	if comparer == nil {
+		return false, ErrNilComparer
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return IsSubsetOfCmp(first, NewOnSliceEn(sl2...), comparer)
+}
+
+// IsSubsetOfCmpSelfMust is like IsSubsetOfCmpSelf but panics in case of error.
+func IsSubsetOfCmpSelfMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) bool {
+	r, err := IsSubsetOfCmpSelf(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsProperSubsetOf determines whether each element of 'first' is contained in 'second' and 'second' has an element not contained in 'first' using reflect.DeepEqual to compare elements.
+// Order and duplicates of elements are ignored.
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IsProperSubsetOfSelf instead.
+func IsProperSubsetOf[Source any](first, second Enumerator[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	r, err := relateSets(first, second, lookupEqFactory[Source](nil), false)
+	if err != nil {
+		return false, err
+	}
+	return !r.firstOnly && r.secondOnly, nil
+}
+
+// IsProperSubsetOfMust is like IsProperSubsetOf but panics in case of error.
+func IsProperSubsetOfMust[Source any](first, second Enumerator[Source]) bool {
+	r, err := IsProperSubsetOf(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsProperSubsetOfSelf determines whether each element of 'first' is contained in 'second' and 'second' has an element not contained in 'first' using reflect.DeepEqual to compare elements.
+// (See IsProperSubsetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IsProperSubsetOfSelf[Source any](first, second Enumerator[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return IsProperSubsetOf(first, NewOnSliceEn(sl2...))
+}
+
+// IsProperSubsetOfSelfMust is like IsProperSubsetOfSelf but panics in case of error.
+func IsProperSubsetOfSelfMust[Source any](first, second Enumerator[Source]) bool {
+	r, err := IsProperSubsetOfSelf(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsProperSubsetOfEq determines whether each element of 'first' is contained in 'second' and 'second' has an element not contained in 'first' using a specified Equaler to compare elements.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See IsProperSubsetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IsProperSubsetOfEqSelf instead.
+func IsProperSubsetOfEq[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	r, err := relateSets(first, second, lookupEqFactory(equaler), false)
+	if err != nil {
+		return false, err
+	}
+	return !r.firstOnly && r.secondOnly, nil
+}
+
+// IsProperSubsetOfEqMust is like IsProperSubsetOfEq but panics in case of error.
+func IsProperSubsetOfEqMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) bool {
+	r, err := IsProperSubsetOfEq(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsProperSubsetOfEqSelf determines whether each element of 'first' is contained in 'second' and 'second' has an element not contained in 'first' using a specified Equaler to compare elements.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See IsProperSubsetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IsProperSubsetOfEqSelf[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return IsProperSubsetOfEq(first, NewOnSliceEn(sl2...), equaler)
+}
+
+// IsProperSubsetOfEqSelfMust is like IsProperSubsetOfEqSelf but panics in case of error.
+func IsProperSubsetOfEqSelfMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) bool {
+	r, err := IsProperSubsetOfEqSelf(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsProperSubsetOfCmp determines whether each element of 'first' is contained in 'second' and 'second' has an element not contained in 'first' using a specified Comparer to compare elements.
+// (See IsProperSubsetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IsProperSubsetOfCmpSelf instead.
+func IsProperSubsetOfCmp[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	if comparer == nil {
+		return false, ErrNilComparer
+	}
+	r, err := relateSets(first, second, lookupCmpFactory(comparer), false)
+	if err != nil {
+		return false, err
+	}
+	return !r.firstOnly && r.secondOnly, nil
+}
+
+// IsProperSubsetOfCmpMust is like IsProperSubsetOfCmp but panics in case of error.
+func IsProperSubsetOfCmpMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) bool {
+	r, err := IsProperSubsetOfCmp(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsProperSubsetOfCmpSelf determines whether each element of 'first' is contained in 'second' and 'second' has an element not contained in 'first' using a specified Comparer to compare elements.
+// (See IsProperSubsetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IsProperSubsetOfCmpSelf[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	if comparer == nil {
+		return false, ErrNilComparer
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return IsProperSubsetOfCmp(first, NewOnSliceEn(sl2...), comparer)
+}
+
+// IsProperSubsetOfCmpSelfMust is like IsProperSubsetOfCmpSelf but panics in case of error.
+func IsProperSubsetOfCmpSelfMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) bool {
+	r, err := IsProperSubsetOfCmpSelf(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,49 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_IsSubsetOfMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  Enumerator[int]
+		second Enumerator[int]
+		want   bool
+	}{
+		{name: "EmptyFirst", first: Empty[int](), second: NewOnSlice(1), want: true},
+		{name: "Equal", first: NewOnSlice(2, 1, 1), second: NewOnSlice(1, 2), want: true},
+		{name: "Subset", first: NewOnSlice(2), second: NewOnSlice(1, 2), want: true},
+		{name: "NotSubset", first: NewOnSlice(2, 3), second: NewOnSlice(1, 2), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsSubsetOfMust(tt.first, tt.second); got != tt.want {
+				t.Errorf("IsSubsetOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_IsProperSubsetOfMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  Enumerator[int]
+		second Enumerator[int]
+		want   bool
+	}{
+		{name: "EmptyBoth", first: Empty[int](), second: Empty[int](), want: false},
+		{name: "Equal", first: NewOnSlice(2, 1, 1), second: NewOnSlice(1, 2), want: false},
+		{name: "Subset", first: NewOnSlice(2, 2), second: NewOnSlice(1, 2), want: true},
+		{name: "NotSubset", first: NewOnSlice(2, 3), second: NewOnSlice(1, 2, 4), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsProperSubsetOfMust(tt.first, tt.second); got != tt.want {
+				t.Errorf("IsProperSubsetOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
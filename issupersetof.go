@@ -0,0 +1,163 @@
+//go:build go1.18
+
+package go2linq
+
+// https://docs.microsoft.com/dotnet/api/system.collections.generic.iset-1
+
+// IsSupersetOf determines whether each element of 'second' is contained in 'first' using reflect.DeepEqual to compare elements.
+// Order and duplicates of elements are ignored, any sequence is a superset of an empty sequence.
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IsSupersetOfSelf instead.
+func IsSupersetOf[Source any](first, second Enumerator[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	r, err := relateSets(first, second, lookupEqFactory[Source](nil), false)
+	if err != nil {
+		return false, err
+	}
+	return !r.secondOnly, nil
+}
+
+// IsSupersetOfMust is like IsSupersetOf but panics in case of error.
+func IsSupersetOfMust[Source any](first, second Enumerator[Source]) bool {
+	r, err := IsSupersetOf(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsSupersetOfSelf determines whether each element of 'second' is contained in 'first' using reflect.DeepEqual to compare elements.
+// (See IsSupersetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IsSupersetOfSelf[Source any](first, second Enumerator[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return IsSupersetOf(first, NewOnSliceEn(sl2...))
+}
+
+// IsSupersetOfSelfMust is like IsSupersetOfSelf but panics in case of error.
+func IsSupersetOfSelfMust[Source any](first, second Enumerator[Source]) bool {
+	r, err := IsSupersetOfSelf(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsSupersetOfEq determines whether each element of 'second' is contained in 'first' using a specified Equaler to compare elements.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See IsSupersetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IsSupersetOfEqSelf instead.
+func IsSupersetOfEq[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	r, err := relateSets(first, second, lookupEqFactory(equaler), false)
+	if err != nil {
+		return false, err
+	}
+	return !r.secondOnly, nil
+}
+
+// IsSupersetOfEqMust is like IsSupersetOfEq but panics in case of error.
+func IsSupersetOfEqMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) bool {
+	r, err := IsSupersetOfEq(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsSupersetOfEqSelf determines whether each element of 'second' is contained in 'first' using a specified Equaler to compare elements.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See IsSupersetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IsSupersetOfEqSelf[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return IsSupersetOfEq(first, NewOnSliceEn(sl2...), equaler)
+}
+
+// IsSupersetOfEqSelfMust is like IsSupersetOfEqSelf but panics in case of error.
+func IsSupersetOfEqSelfMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) bool {
+	r, err := IsSupersetOfEqSelf(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsSupersetOfCmp determines whether each element of 'second' is contained in 'first' using a specified Comparer to compare elements.
+// (See IsSupersetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use IsSupersetOfCmpSelf instead.
+func IsSupersetOfCmp[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	if comparer == nil {
+		return false, ErrNilComparer
+	}
+	r, err := relateSets(first, second, lookupCmpFactory(comparer), false)
+	if err != nil {
+		return false, err
+	}
+	return !r.secondOnly, nil
+}
+
+// IsSupersetOfCmpMust is like IsSupersetOfCmp but panics in case of error.
+func IsSupersetOfCmpMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) bool {
+	r, err := IsSupersetOfCmp(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsSupersetOfCmpSelf determines whether each element of 'second' is contained in 'first' using a specified Comparer to compare elements.
+// (See IsSupersetOf function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func IsSupersetOfCmpSelf[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	if comparer == nil {
+		return false, ErrNilComparer
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return IsSupersetOfCmp(first, NewOnSliceEn(sl2...), comparer)
+}
+
+// IsSupersetOfCmpSelfMust is like IsSupersetOfCmpSelf but panics in case of error.
+func IsSupersetOfCmpSelfMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) bool {
+	r, err := IsSupersetOfCmpSelf(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
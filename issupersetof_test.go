@@ -0,0 +1,27 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_IsSupersetOfMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  Enumerator[int]
+		second Enumerator[int]
+		want   bool
+	}{
+		{name: "EmptySecond", first: NewOnSlice(1), second: Empty[int](), want: true},
+		{name: "Superset", first: NewOnSlice(1, 2, 3), second: NewOnSlice(3, 3, 1), want: true},
+		{name: "NotSuperset", first: NewOnSlice(1, 2), second: NewOnSlice(2, 4), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsSupersetOfMust(tt.first, tt.second); got != tt.want {
+				t.Errorf("IsSupersetOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
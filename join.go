@@ -47,6 +47,9 @@ func JoinSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enum
 		return nil, ErrNilSelector
 	}
 	isl := Slice(inner)
+	if err := Err(inner); err != nil {
+		return nil, err
+	}
 	outer.Reset()
 	return Join(outer, NewOnSliceEn(isl...), outerKeySelector, innerKeySelector, resultSelector)
 }
@@ -68,6 +71,9 @@ func JoinSelfMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner
 //
 // (The similar to keys equality comparison functionality may be achieved using appropriate key selectors.
 // See CustomComparer test for usage of case insensitive string keys.)
+//
+// If 'equaler' does not implement Hasher, the keys are compared with each distinct key of 'inner'.
+// JoinHash, JoinComparable (hash join) and JoinMerge (sort-merge join) are more efficient alternatives.
 func JoinEq[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
 	innerKeySelector func(Inner) Key, resultSelector func(Outer, Inner) Result, equaler Equaler[Key]) (Enumerator[Result], error) {
 	if outer == nil || inner == nil {
@@ -81,12 +87,19 @@ func JoinEq[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumer
 	}
 	var once sync.Once
 	var ilk *Lookup[Key, Inner]
+	var ierr error
 	t := Empty[Inner]()
 	var oel Outer
 	var iel Inner
 	return OnFunc[Result]{
 			mvNxt: func() bool {
-				once.Do(func() { ilk = ToLookupEqMust(inner, innerKeySelector, equaler) })
+				once.Do(func() {
+					ilk, ierr = ToLookupEq(inner, innerKeySelector, equaler)
+					Close(inner)
+				})
+				if ierr != nil {
+					return false
+				}
 				for {
 					if t.MoveNext() {
 						iel = t.Current()
@@ -101,6 +114,8 @@ func JoinEq[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumer
 			},
 			crrnt: func() Result { return resultSelector(oel, iel) },
 			rst:   func() { t = Empty[Inner](); outer.Reset() },
+			err:   func() error { return firstErr(ierr, Err(outer)) },
+			cls:   func() error { return firstErr(Close(outer), Close(inner)) },
 		},
 		nil
 }
@@ -129,6 +144,9 @@ func JoinEqSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner En
 		return nil, ErrNilSelector
 	}
 	isl := Slice(inner)
+	if err := Err(inner); err != nil {
+		return nil, err
+	}
 	outer.Reset()
 	return JoinEq(outer, NewOnSliceEn(isl...), outerKeySelector, innerKeySelector, resultSelector, equaler)
 }
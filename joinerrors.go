@@ -0,0 +1,12 @@
+//go:build go1.20
+
+package go2linq
+
+import (
+	"errors"
+)
+
+// joinErrors returns an error that wraps 'errs' (see errors.Join)
+func joinErrors(errs []error) error {
+	return errors.Join(errs...)
+}
@@ -0,0 +1,38 @@
+//go:build go1.18 && !go1.20
+
+package go2linq
+
+import (
+	"strings"
+)
+
+// joinedErrors is a substitute of the errors.Join's result for Go versions prior to 1.20
+type joinedErrors struct {
+	errs []error
+}
+
+// Error implements the error interface.
+func (je *joinedErrors) Error() string {
+	var b strings.Builder
+	for i, err := range je.errs {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// joinErrors returns an error that wraps 'errs', nil errors are discarded
+func joinErrors(errs []error) error {
+	var ee []error
+	for _, err := range errs {
+		if err != nil {
+			ee = append(ee, err)
+		}
+	}
+	if len(ee) == 0 {
+		return nil
+	}
+	return &joinedErrors{errs: ee}
+}
@@ -0,0 +1,125 @@
+//go:build go1.18
+
+package go2linq
+
+// https://morelinq.github.io/3.3/ref/api/html/Overload_MoreLinq_MoreEnumerable_Lag.htm
+// https://morelinq.github.io/3.3/ref/api/html/Overload_MoreLinq_MoreEnumerable_Lead.htm
+
+// Lag applies 'resultSelector' to each element of the sequence and the element 'offset' positions before it.
+// 'defaultValue' is used in place of the lagging element for the first 'offset' elements.
+// Only the last 'offset' elements are buffered.
+func Lag[Source, Result any](source Enumerator[Source], offset int, defaultValue Source,
+	resultSelector func(Source, Source) Result) (Enumerator[Result], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if offset <= 0 {
+		return nil, ErrOffsetOutOfRange
+	}
+	if resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	// ring - the last 'offset' elements, the element with the number i is at i%offset
+	ring := make([]Source, 0, offset)
+	i := 0
+	var cur, lagged Source
+	return OnFunc[Result]{
+			mvNxt: func() bool {
+				if !source.MoveNext() {
+					return false
+				}
+				cur = source.Current()
+				if len(ring) < offset {
+					lagged = defaultValue
+					ring = append(ring, cur)
+				} else {
+					lagged = ring[i%offset]
+					ring[i%offset] = cur
+				}
+				i++
+				return true
+			},
+			crrnt: func() Result { return resultSelector(cur, lagged) },
+			rst: func() {
+				ring, i = ring[:0], 0
+				source.Reset()
+			},
+			err: func() error { return Err(source) },
+			cls: func() error { return Close(source) },
+		},
+		nil
+}
+
+// LagMust is like Lag but panics in case of error.
+func LagMust[Source, Result any](source Enumerator[Source], offset int, defaultValue Source,
+	resultSelector func(Source, Source) Result) Enumerator[Result] {
+	r, err := Lag(source, offset, defaultValue, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Lead applies 'resultSelector' to each element of the sequence and the element 'offset' positions after it.
+// 'defaultValue' is used in place of the leading element for the last 'offset' elements.
+// Only the next 'offset' elements are buffered.
+func Lead[Source, Result any](source Enumerator[Source], offset int, defaultValue Source,
+	resultSelector func(Source, Source) Result) (Enumerator[Result], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if offset <= 0 {
+		return nil, ErrOffsetOutOfRange
+	}
+	if resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	// ring - the current element (at 'head') followed by up to 'offset' next ones ('n' elements in total)
+	ring := make([]Source, offset+1)
+	head, n := 0, 0
+	started, done := false, false
+	var cur, leading Source
+	return OnFunc[Result]{
+			mvNxt: func() bool {
+				if started && n > 0 {
+					head = (head + 1) % len(ring)
+					n--
+				}
+				started = true
+				for !done && n < len(ring) {
+					if !source.MoveNext() {
+						done = true
+						break
+					}
+					ring[(head+n)%len(ring)] = source.Current()
+					n++
+				}
+				if n == 0 || Err(source) != nil {
+					return false
+				}
+				cur, leading = ring[head], defaultValue
+				if n > offset {
+					leading = ring[(head+offset)%len(ring)]
+				}
+				return true
+			},
+			crrnt: func() Result { return resultSelector(cur, leading) },
+			rst: func() {
+				head, n, started, done = 0, 0, false, false
+				source.Reset()
+			},
+			err: func() error { return Err(source) },
+			cls: func() error { return Close(source) },
+		},
+		nil
+}
+
+// LeadMust is like Lead but panics in case of error.
+func LeadMust[Source, Result any](source Enumerator[Source], offset int, defaultValue Source,
+	resultSelector func(Source, Source) Result) Enumerator[Result] {
+	r, err := Lead(source, offset, defaultValue, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,82 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"fmt"
+	"testing"
+)
+
+func Test_LagMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		source Enumerator[int]
+		offset int
+		want   Enumerator[string]
+	}{
+		{name: "EmptySource", source: Empty[int](), offset: 1, want: Empty[string]()},
+		{name: "Offset1", source: NewOnSlice(1, 2, 3), offset: 1, want: NewOnSlice("1:-1", "2:1", "3:2")},
+		{name: "Offset2", source: NewOnSlice(1, 2, 3, 4, 5), offset: 2, want: NewOnSlice("1:-1", "2:-1", "3:1", "4:2", "5:3")},
+		{name: "OffsetBeyondEnd", source: NewOnSlice(1, 2), offset: 5, want: NewOnSlice("1:-1", "2:-1")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LagMust(tt.source, tt.offset, -1, func(cur, lagged int) string { return fmt.Sprintf("%d:%d", cur, lagged) })
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("Lag() = '%v', want '%v'", String(got), String(tt.want))
+			}
+			got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(got, tt.want) {
+				t.Errorf("Lag() after Reset differs")
+			}
+		})
+	}
+}
+
+func Test_LeadMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		source Enumerator[int]
+		offset int
+		want   Enumerator[string]
+	}{
+		{name: "EmptySource", source: Empty[int](), offset: 1, want: Empty[string]()},
+		{name: "Offset1", source: NewOnSlice(1, 2, 3), offset: 1, want: NewOnSlice("1:2", "2:3", "3:-1")},
+		{name: "Offset2", source: NewOnSlice(1, 2, 3, 4, 5), offset: 2, want: NewOnSlice("1:3", "2:4", "3:5", "4:-1", "5:-1")},
+		{name: "OffsetBeyondEnd", source: NewOnSlice(1, 2), offset: 5, want: NewOnSlice("1:-1", "2:-1")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LeadMust(tt.source, tt.offset, -1, func(cur, leading int) string { return fmt.Sprintf("%d:%d", cur, leading) })
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("Lead() = '%v', want '%v'", String(got), String(tt.want))
+			}
+			got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(got, tt.want) {
+				t.Errorf("Lead() after Reset differs")
+			}
+		})
+	}
+}
+
+func Test_LagLead_errors(t *testing.T) {
+	rs := func(x, y int) int { return x }
+	if _, err := Lag(NewOnSliceEn(1), 0, 0, rs); err != ErrOffsetOutOfRange {
+		t.Errorf("Lag() error = '%v', want '%v'", err, ErrOffsetOutOfRange)
+	}
+	if _, err := Lead(NewOnSliceEn(1), -1, 0, rs); err != ErrOffsetOutOfRange {
+		t.Errorf("Lead() error = '%v', want '%v'", err, ErrOffsetOutOfRange)
+	}
+	if _, err := Lead[int, int](NewOnSliceEn(1), 1, 0, nil); err != ErrNilSelector {
+		t.Errorf("Lead() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+	if _, err := SliceErr(LeadMust[int, int](failAt(NewOnSliceEn(1, 2, 3), 3), 1, 0, rs)); err != errTest {
+		t.Errorf("Lead() error = '%v', want '%v'", err, errTest)
+	}
+}
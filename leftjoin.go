@@ -0,0 +1,179 @@
+//go:build go1.18
+
+package go2linq
+
+// https://docs.microsoft.com/dotnet/api/system.linq.enumerable.leftjoin
+// https://docs.microsoft.com/dotnet/csharp/linq/perform-left-outer-joins
+
+// LeftJoin correlates the elements of two sequences based on matching keys (left outer join).
+// Each element of 'outer' is paired with each matching element of 'inner'.
+// If there are no matching elements, the element of 'outer' is passed to 'resultSelector' with nil.
+// reflect.DeepEqual is used to compare keys. 'inner' is enumerated immediately.
+// 'outer' and 'inner' must not be based on the same Enumerator, otherwise use LeftJoinSelf instead.
+func LeftJoin[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, *Inner) Result) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return outerJoin(outer, inner, outerKeySelector, innerKeySelector,
+		func(o *Outer, i *Inner) Result { return resultSelector(*o, i) }, lookupEqFactory[Key](nil), false), nil
+}
+
+// LeftJoinMust is like LeftJoin but panics in case of error.
+func LeftJoinMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, *Inner) Result) Enumerator[Result] {
+	r, err := LeftJoin(outer, inner, outerKeySelector, innerKeySelector, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// LeftJoinSelf correlates the elements of two sequences based on matching keys (left outer join).
+// Each element of 'outer' is paired with each matching element of 'inner'.
+// If there are no matching elements, the element of 'outer' is passed to 'resultSelector' with nil.
+// reflect.DeepEqual is used to compare keys. 'inner' is enumerated immediately.
+// 'outer' and 'inner' may be based on the same Enumerator.
+// 'outer' must have real Reset method.
+func LeftJoinSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, *Inner) Result) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return outerJoinSelf(outer, inner, outerKeySelector, innerKeySelector,
+		func(o *Outer, i *Inner) Result { return resultSelector(*o, i) }, lookupEqFactory[Key](nil), false)
+}
+
+// LeftJoinSelfMust is like LeftJoinSelf but panics in case of error.
+func LeftJoinSelfMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, *Inner) Result) Enumerator[Result] {
+	r, err := LeftJoinSelf(outer, inner, outerKeySelector, innerKeySelector, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// LeftJoinEq correlates the elements of two sequences based on matching keys (left outer join).
+// Each element of 'outer' is paired with each matching element of 'inner'.
+// If there are no matching elements, the element of 'outer' is passed to 'resultSelector' with nil.
+// A specified Equaler is used to compare keys.
+// If 'equaler' is nil reflect.DeepEqual is used. 'inner' is enumerated immediately.
+// 'outer' and 'inner' must not be based on the same Enumerator, otherwise use LeftJoinEqSelf instead.
+func LeftJoinEq[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, *Inner) Result, equaler Equaler[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return outerJoin(outer, inner, outerKeySelector, innerKeySelector,
+		func(o *Outer, i *Inner) Result { return resultSelector(*o, i) }, lookupEqFactory(equaler), false), nil
+}
+
+// LeftJoinEqMust is like LeftJoinEq but panics in case of error.
+func LeftJoinEqMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, *Inner) Result, equaler Equaler[Key]) Enumerator[Result] {
+	r, err := LeftJoinEq(outer, inner, outerKeySelector, innerKeySelector, resultSelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// LeftJoinEqSelf correlates the elements of two sequences based on matching keys (left outer join).
+// Each element of 'outer' is paired with each matching element of 'inner'.
+// If there are no matching elements, the element of 'outer' is passed to 'resultSelector' with nil.
+// A specified Equaler is used to compare keys.
+// If 'equaler' is nil reflect.DeepEqual is used. 'inner' is enumerated immediately.
+// 'outer' and 'inner' may be based on the same Enumerator.
+// 'outer' must have real Reset method.
+func LeftJoinEqSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, *Inner) Result, equaler Equaler[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return outerJoinSelf(outer, inner, outerKeySelector, innerKeySelector,
+		func(o *Outer, i *Inner) Result { return resultSelector(*o, i) }, lookupEqFactory(equaler), false)
+}
+
+// LeftJoinEqSelfMust is like LeftJoinEqSelf but panics in case of error.
+func LeftJoinEqSelfMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, *Inner) Result, equaler Equaler[Key]) Enumerator[Result] {
+	r, err := LeftJoinEqSelf(outer, inner, outerKeySelector, innerKeySelector, resultSelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// LeftJoinCmp correlates the elements of two sequences based on matching keys (left outer join).
+// Each element of 'outer' is paired with each matching element of 'inner'.
+// If there are no matching elements, the element of 'outer' is passed to 'resultSelector' with nil.
+// A specified Comparer is used to compare keys. 'inner' is enumerated immediately.
+// 'outer' and 'inner' must not be based on the same Enumerator, otherwise use LeftJoinCmpSelf instead.
+func LeftJoinCmp[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, *Inner) Result, comparer Comparer[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return outerJoin(outer, inner, outerKeySelector, innerKeySelector,
+		func(o *Outer, i *Inner) Result { return resultSelector(*o, i) }, lookupCmpFactory(comparer), false), nil
+}
+
+// LeftJoinCmpMust is like LeftJoinCmp but panics in case of error.
+func LeftJoinCmpMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, *Inner) Result, comparer Comparer[Key]) Enumerator[Result] {
+	r, err := LeftJoinCmp(outer, inner, outerKeySelector, innerKeySelector, resultSelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// LeftJoinCmpSelf correlates the elements of two sequences based on matching keys (left outer join).
+// Each element of 'outer' is paired with each matching element of 'inner'.
+// If there are no matching elements, the element of 'outer' is passed to 'resultSelector' with nil.
+// A specified Comparer is used to compare keys. 'inner' is enumerated immediately.
+// 'outer' and 'inner' may be based on the same Enumerator.
+// 'outer' must have real Reset method.
+func LeftJoinCmpSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, *Inner) Result, comparer Comparer[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return outerJoinSelf(outer, inner, outerKeySelector, innerKeySelector,
+		func(o *Outer, i *Inner) Result { return resultSelector(*o, i) }, lookupCmpFactory(comparer), false)
+}
+
+// LeftJoinCmpSelfMust is like LeftJoinCmpSelf but panics in case of error.
+func LeftJoinCmpSelfMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, *Inner) Result, comparer Comparer[Key]) Enumerator[Result] {
+	r, err := LeftJoinCmpSelf(outer, inner, outerKeySelector, innerKeySelector, resultSelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,155 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"fmt"
+	"testing"
+)
+
+// joinResult formats the result of an outer join, "-" stands for a missing element
+func joinResult[Outer, Inner any](o *Outer, i *Inner) string {
+	os, is := "-", "-"
+	if o != nil {
+		os = fmt.Sprint(*o)
+	}
+	if i != nil {
+		is = fmt.Sprint(*i)
+	}
+	return os + ":" + is
+}
+
+func Test_LeftJoinMust(t *testing.T) {
+	type args struct {
+		outer            Enumerator[string]
+		inner            Enumerator[string]
+		outerKeySelector func(string) rune
+		innerKeySelector func(string) rune
+	}
+	tests := []struct {
+		name string
+		args args
+		want Enumerator[string]
+	}{
+		{name: "SimpleLeftJoin",
+			args: args{
+				outer:            NewOnSlice("first", "second", "third"),
+				inner:            NewOnSlice("essence", "offer", "eating", "psalm"),
+				outerKeySelector: func(oel string) rune { return ([]rune(oel))[0] },
+				innerKeySelector: func(iel string) rune { return ([]rune(iel))[1] },
+			},
+			want: NewOnSlice("first:offer", "second:essence", "second:psalm", "third:-"),
+		},
+		{name: "EmptyInner",
+			args: args{
+				outer:            NewOnSlice("first", "second"),
+				inner:            Empty[string](),
+				outerKeySelector: func(oel string) rune { return ([]rune(oel))[0] },
+				innerKeySelector: func(iel string) rune { return ([]rune(iel))[0] },
+			},
+			want: NewOnSlice("first:-", "second:-"),
+		},
+		{name: "EmptyOuter",
+			args: args{
+				outer:            Empty[string](),
+				inner:            NewOnSlice("first", "second"),
+				outerKeySelector: func(oel string) rune { return ([]rune(oel))[0] },
+				innerKeySelector: func(iel string) rune { return ([]rune(iel))[0] },
+			},
+			want: Empty[string](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LeftJoinMust(tt.args.outer, tt.args.inner, tt.args.outerKeySelector, tt.args.innerKeySelector,
+				func(oel string, iel *string) string { return joinResult(&oel, iel) })
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("LeftJoin() = '%v', want '%v'", String(got), String(tt.want))
+			}
+		})
+	}
+}
+
+func Test_LeftJoin_ZeroValue(t *testing.T) {
+	// the inner element with zero value must not be confused with the missing one
+	got := LeftJoinMust(NewOnSlice(0, 1, 2), NewOnSlice(0, 2), Identity[int], Identity[int],
+		func(oel int, iel *int) string { return joinResult(&oel, iel) })
+	want := NewOnSlice("0:0", "1:-", "2:2")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("LeftJoin() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_LeftJoinEqMust(t *testing.T) {
+	got := LeftJoinEqMust(
+		NewOnSlice("ABCxxx", "abcyyy", "defzzz", "ghizzz"),
+		NewOnSlice("000abc", "111gHi", "222333"),
+		func(oel string) string { return oel[:3] },
+		func(iel string) string { return iel[3:] },
+		func(oel string, iel *string) string { return joinResult(&oel, iel) },
+		CaseInsensitiveEqualer,
+	)
+	want := NewOnSlice("ABCxxx:000abc", "abcyyy:000abc", "defzzz:-", "ghizzz:111gHi")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("LeftJoinEq() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_LeftJoinCmpMust(t *testing.T) {
+	got := LeftJoinCmpMust(
+		NewOnSlice("ABCxxx", "abcyyy", "defzzz", "ghizzz"),
+		NewOnSlice("000abc", "111gHi", "222ABC"),
+		func(oel string) string { return oel[:3] },
+		func(iel string) string { return iel[3:] },
+		func(oel string, iel *string) string { return joinResult(&oel, iel) },
+		CaseInsensitiveComparer,
+	)
+	want := NewOnSlice("ABCxxx:000abc", "ABCxxx:222ABC", "abcyyy:000abc", "abcyyy:222ABC", "defzzz:-", "ghizzz:111gHi")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("LeftJoinCmp() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_LeftJoinSelfMust(t *testing.T) {
+	source := NewOnSlice("fs", "sf", "ff", "xy")
+	got := LeftJoinSelfMust(source, source,
+		func(oel string) byte { return oel[0] },
+		func(iel string) byte { return iel[1] },
+		func(oel string, iel *string) string { return joinResult(&oel, iel) },
+	)
+	want := NewOnSlice("fs:sf", "fs:ff", "sf:fs", "ff:sf", "ff:ff", "xy:-")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("LeftJoinSelf() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_LeftJoin_errors(t *testing.T) {
+	rs := func(oel int, iel *int) int { return oel }
+	if _, err := LeftJoin(nil, NewOnSliceEn(1), Identity[int], Identity[int], rs); err != ErrNilSource {
+		t.Errorf("LeftJoin() error = '%v', want '%v'", err, ErrNilSource)
+	}
+	if _, err := LeftJoin[int, int, int, int](NewOnSliceEn(1), NewOnSliceEn(1), Identity[int], Identity[int], nil); err != ErrNilSelector {
+		t.Errorf("LeftJoin() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+	if _, err := LeftJoinCmp(NewOnSliceEn(1), NewOnSliceEn(1), Identity[int], Identity[int], rs, nil); err != ErrNilComparer {
+		t.Errorf("LeftJoinCmp() error = '%v', want '%v'", err, ErrNilComparer)
+	}
+	got := LeftJoinMust[int, int, int, int](NewOnSliceEn(1), failAt(NewOnSliceEn(1, 2), 2), Identity[int], Identity[int], rs)
+	if _, err := SliceErr(got); err != errTest {
+		t.Errorf("LeftJoin() error = '%v', want '%v'", err, errTest)
+	}
+	got = LeftJoinMust[int, int, int, int](failAt(NewOnSliceEn(1, 2), 2), NewOnSliceEn(1), Identity[int], Identity[int], rs)
+	if _, err := SliceErr(got); err != errTest {
+		t.Errorf("LeftJoin() error = '%v', want '%v'", err, errTest)
+	}
+}
@@ -4,6 +4,7 @@ package go2linq
 
 import (
 	"reflect"
+	"sort"
 	"strings"
 )
 
@@ -15,13 +16,109 @@ type Lookup[Key, Element any] struct {
 	grgr []Grouping[Key, Element]
 	// keyEq is an equaler for grgr's keys
 	keyEq Equaler[Key]
+	// keyIdx is an optional index of grgr's keys
+	keyIdx lookupIndex[Key]
 }
 
-// newLookupEq creates new empty Lookup with the provided keys equaler
+// lookupIndex maps Lookup's keys to the indexes of the corresponding groupings
+type lookupIndex[Key any] interface {
+	// get returns the index of the grouping with the specified key
+	get(Key) (int, bool)
+	// set sets the index of the grouping with the specified key
+	set(Key, int)
+}
+
+// hashIndex is a lookupIndex based on a Hasher
+type hashIndex[Key any] struct {
+	hasher  Hasher[Key]
+	buckets map[uint64][]KeyElement[Key, int]
+}
+
+func (hi *hashIndex[Key]) get(key Key) (int, bool) {
+	for _, ke := range hi.buckets[hi.hasher.Hash(key)] {
+		if hi.hasher.Equal(ke.key, key) {
+			return ke.element, true
+		}
+	}
+	return -1, false
+}
+
+func (hi *hashIndex[Key]) set(key Key, i int) {
+	h := hi.hasher.Hash(key)
+	hi.buckets[h] = append(hi.buckets[h], KeyElement[Key, int]{key: key, element: i})
+}
+
+// mapIndex is a lookupIndex based on a map
+type mapIndex[Key comparable] map[Key]int
+
+func (mi mapIndex[Key]) get(key Key) (int, bool) {
+	i, ok := mi[key]
+	return i, ok
+}
+
+func (mi mapIndex[Key]) set(key Key, i int) {
+	mi[key] = i
+}
+
+// cmpIndex is a lookupIndex based on a Comparer
+type cmpIndex[Key any] struct {
+	cmp Comparer[Key]
+	// kk - the keys sorted by 'cmp' with the indexes of the corresponding groupings
+	kk []KeyElement[Key, int]
+}
+
+// search returns the position of 'key' in ci.kk (see https://pkg.go.dev/sort#Search)
+func (ci *cmpIndex[Key]) search(key Key) int {
+	return sort.Search(len(ci.kk), func(i int) bool {
+		return ci.cmp.Compare(key, ci.kk[i].key) <= 0
+	})
+}
+
+func (ci *cmpIndex[Key]) get(key Key) (int, bool) {
+	i := ci.search(key)
+	if i < len(ci.kk) && ci.cmp.Compare(key, ci.kk[i].key) == 0 {
+		return ci.kk[i].element, true
+	}
+	return -1, false
+}
+
+func (ci *cmpIndex[Key]) set(key Key, i int) {
+	elIntoElelAtIdx(KeyElement[Key, int]{key: key, element: i}, &ci.kk, ci.search(key))
+}
+
+// newLookupEq creates new empty Lookup with the provided keys equaler.
+// If 'keq' implements Hasher, the Lookup's keys are indexed with the help of the Hasher.
 func newLookupEq[Key, Element any](keq Equaler[Key]) *Lookup[Key, Element] {
+	if hasher, ok := keq.(Hasher[Key]); ok {
+		return newLookupHash[Key, Element](hasher)
+	}
 	return &Lookup[Key, Element]{keyEq: keq}
 }
 
+// newLookupHash creates new empty Lookup with the provided keys hasher
+func newLookupHash[Key, Element any](hasher Hasher[Key]) *Lookup[Key, Element] {
+	return &Lookup[Key, Element]{
+		keyEq:  hasher,
+		keyIdx: &hashIndex[Key]{hasher: hasher, buckets: make(map[uint64][]KeyElement[Key, int])},
+	}
+}
+
+// newLookupCmp creates new empty Lookup with the provided keys comparer
+func newLookupCmp[Key, Element any](cmp Comparer[Key]) *Lookup[Key, Element] {
+	return &Lookup[Key, Element]{
+		keyEq:  ComparerFunc[Key](cmp.Compare),
+		keyIdx: &cmpIndex[Key]{cmp: cmp},
+	}
+}
+
+// newLookupComparable creates new empty Lookup for comparable keys using == to compare keys
+func newLookupComparable[Key comparable, Element any]() *Lookup[Key, Element] {
+	return &Lookup[Key, Element]{
+		keyEq:  EqualerFunc[Key](func(x, y Key) bool { return x == y }),
+		keyIdx: make(mapIndex[Key]),
+	}
+}
+
 // newLookup creates new empty Lookup using reflect.DeepEqual as keys equaler
 func newLookup[Key, Element any]() *Lookup[Key, Element] {
 	var keq Equaler[Key] = EqualerFunc[Key](DeepEqual[Key])
@@ -29,6 +126,12 @@ func newLookup[Key, Element any]() *Lookup[Key, Element] {
 }
 
 func (lk *Lookup[Key, Element]) keyIndex(key Key) int {
+	if lk.keyIdx != nil {
+		if i, ok := lk.keyIdx.get(key); ok {
+			return i
+		}
+		return -1
+	}
 	for i, g := range lk.grgr {
 		if lk.keyEq.Equal(g.key, key) {
 			return i
@@ -45,6 +148,9 @@ func (lk *Lookup[Key, Element]) add(key Key, el Element) {
 	} else {
 		gr := Grouping[Key, Element]{key: key, values: []Element{el}}
 		lk.grgr = append(lk.grgr, gr)
+		if lk.keyIdx != nil {
+			lk.keyIdx.set(key, len(lk.grgr)-1)
+		}
 	}
 }
 
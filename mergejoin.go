@@ -0,0 +1,125 @@
+//go:build go1.18
+
+package go2linq
+
+// https://en.wikipedia.org/wiki/Sort-merge_join
+
+// JoinMerge correlates the elements of two sequences based on matching keys.
+// Both 'outer' and 'inner' must be sorted in ascending order of their keys by a specified Comparer.
+//
+// The sequences are merged in a single pass, so they are not enumerated immediately.
+// Only the elements of 'inner' with the same key are buffered
+// (to pair them with each element of 'outer' having this key),
+// so if the keys are unique JoinMerge works in O(1) memory.
+// Order of elements in the result corresponds to the order of elements in 'outer'.
+// If the keys of either sequence are found to be out of order, the enumeration stops
+// and ErrNotSorted is returned by the Err method of the resulting Enumerator (see ErrEnumerator).
+// 'outer' and 'inner' must not be based on the same Enumerator.
+func JoinMerge[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, Inner) Result, comparer Comparer[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	var oel Outer
+	var okey Key
+	var oStarted bool
+	// grp - the inner elements with the key equal to 'gkey', gi - the position of the next element in 'grp'
+	var grp []Inner
+	var gkey Key
+	var grpValid bool
+	var gi int
+	// pending - the inner element read ahead, pkey - its key
+	var pending Inner
+	var pkey Key
+	var hasPending, iStarted, innerDone bool
+	var iel Inner
+	var err error
+	// nextInner reads the next inner element into 'pending'
+	nextInner := func() bool {
+		if innerDone || !inner.MoveNext() {
+			innerDone = true
+			return false
+		}
+		pending = inner.Current()
+		k := innerKeySelector(pending)
+		if iStarted && comparer.Compare(k, pkey) < 0 {
+			err = ErrNotSorted
+			return false
+		}
+		pkey, iStarted, hasPending = k, true, true
+		return true
+	}
+	return OnFunc[Result]{
+		mvNxt: func() bool {
+			if err != nil {
+				return false
+			}
+			for {
+				if gi < len(grp) {
+					iel = grp[gi]
+					gi++
+					return true
+				}
+				if grpValid && len(grp) == 0 && innerDone {
+					// no more inner elements to match
+					return false
+				}
+				if !outer.MoveNext() {
+					return false
+				}
+				o := outer.Current()
+				k := outerKeySelector(o)
+				if oStarted && comparer.Compare(k, okey) < 0 {
+					err = ErrNotSorted
+					return false
+				}
+				oel, okey, oStarted = o, k, true
+				gi = 0
+				if grpValid && comparer.Compare(k, gkey) == 0 {
+					continue
+				}
+				grp = grp[:0]
+				for hasPending || nextInner() {
+					c := comparer.Compare(pkey, k)
+					if c > 0 {
+						break
+					}
+					if c == 0 {
+						grp = append(grp, pending)
+					}
+					hasPending = false
+				}
+				if err != nil {
+					return false
+				}
+				gkey, grpValid = k, true
+			}
+		},
+		crrnt: func() Result { return resultSelector(oel, iel) },
+		rst: func() {
+			outer.Reset()
+			inner.Reset()
+			oStarted, grp, grpValid, gi = false, nil, false, 0
+			hasPending, iStarted, innerDone = false, false, false
+			err = nil
+		},
+		err: func() error { return firstErr(err, Err(outer), Err(inner)) },
+		cls: func() error { return firstErr(Close(outer), Close(inner)) },
+	}, nil
+}
+
+// JoinMergeMust is like JoinMerge but panics in case of error.
+func JoinMergeMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(Outer, Inner) Result, comparer Comparer[Key]) Enumerator[Result] {
+	r, err := JoinMerge(outer, inner, outerKeySelector, innerKeySelector, resultSelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,134 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_JoinMergeMust(t *testing.T) {
+	type args struct {
+		outer Enumerator[int]
+		inner Enumerator[int]
+	}
+	tests := []struct {
+		name string
+		args args
+		want Enumerator[string]
+	}{
+		{name: "UniqueKeys",
+			args: args{
+				outer: NewOnSlice(1, 3, 5, 7),
+				inner: NewOnSlice(2, 3, 4, 5, 6),
+			},
+			want: NewOnSlice("3:3", "5:5"),
+		},
+		{name: "DuplicateKeys",
+			args: args{
+				outer: NewOnSlice(1, 2, 2, 3, 5),
+				inner: NewOnSlice(2, 2, 3, 3, 4),
+			},
+			want: NewOnSlice("2:2", "2:2", "2:2", "2:2", "3:3", "3:3"),
+		},
+		{name: "EmptyOuter",
+			args: args{
+				outer: Empty[int](),
+				inner: NewOnSlice(1, 2),
+			},
+			want: Empty[string](),
+		},
+		{name: "EmptyInner",
+			args: args{
+				outer: NewOnSlice(1, 2),
+				inner: Empty[int](),
+			},
+			want: Empty[string](),
+		},
+		{name: "NoMatch",
+			args: args{
+				outer: NewOnSlice(1, 3, 5),
+				inner: NewOnSlice(2, 4, 6),
+			},
+			want: Empty[string](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := JoinMergeMust(tt.args.outer, tt.args.inner, Identity[int], Identity[int],
+				func(oel, iel int) string { return joinResult(&oel, &iel) },
+				Order[int]{},
+			)
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("JoinMerge() = '%v', want '%v'", String(got), String(tt.want))
+			}
+			got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(got, tt.want) {
+				t.Errorf("JoinMerge() after Reset differs")
+			}
+		})
+	}
+}
+
+func Test_JoinMerge_keys(t *testing.T) {
+	type order struct {
+		id       int
+		customer string
+	}
+	got := JoinMergeMust(
+		NewOnSlice("ann", "bob", "cid"),
+		NewOnSlice(order{1, "ann"}, order{2, "ann"}, order{3, "cid"}, order{4, "dan"}),
+		Identity[string],
+		func(o order) string { return o.customer },
+		func(c string, o order) string { return joinResult(&c, &o.id) },
+		Order[string]{},
+	)
+	want := NewOnSlice("ann:1", "ann:2", "cid:3")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("JoinMerge() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_JoinMerge_Streaming(t *testing.T) {
+	// the inner sequence is infinite, but the join stops when the outer one ends
+	inner := OnFunc[int]{}
+	i := 0
+	inner.mvNxt = func() bool { i++; return true }
+	inner.crrnt = func() int { return i }
+	got := JoinMergeMust[int, int, int, int](NewOnSlice(2, 4, 6), inner, Identity[int], Identity[int],
+		func(oel, iel int) int { return oel * iel }, Order[int]{})
+	want := NewOnSlice(4, 16, 36)
+	if !SequenceEqualMust(got, want) {
+		t.Errorf("JoinMerge() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_JoinMerge_errors(t *testing.T) {
+	rs := func(oel, iel int) int { return oel }
+	if _, err := JoinMerge[int, int, int, int](NewOnSliceEn(1), NewOnSliceEn(1), Identity[int], Identity[int], rs, nil); err != ErrNilComparer {
+		t.Errorf("JoinMerge() error = '%v', want '%v'", err, ErrNilComparer)
+	}
+	tests := []struct {
+		name  string
+		outer Enumerator[int]
+		inner Enumerator[int]
+		want  error
+	}{
+		{name: "OuterNotSorted", outer: NewOnSlice(1, 3, 2), inner: NewOnSlice(1, 2, 3), want: ErrNotSorted},
+		{name: "InnerNotSorted", outer: NewOnSlice(1, 2, 3), inner: NewOnSlice(1, 3, 2), want: ErrNotSorted},
+		{name: "OuterError", outer: failAt(NewOnSlice(1, 2, 3), 2), inner: NewOnSlice(1, 2, 3), want: errTest},
+		{name: "InnerError", outer: NewOnSlice(1, 2, 3), inner: failAt(NewOnSlice(1, 2, 3), 2), want: errTest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := JoinMergeMust(tt.outer, tt.inner, Identity[int], Identity[int], rs, Order[int]{})
+			if _, err := SliceErr(got); err != tt.want {
+				t.Errorf("JoinMerge() error = '%v', want '%v'", err, tt.want)
+			}
+		})
+	}
+}
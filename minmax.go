@@ -13,6 +13,10 @@ package go2linq
 // corresponding projected value (with count of 'source' elements) is returned
 func minMaxResPrim[Source, Result any](source Enumerator[Source],
 	selector func(Source) Result, lesser Lesser[Result], min bool) (Result, int) {
+	if op, ok := source.(orderedPartition[Source]); ok && op.fresh() {
+		_, rs, count := minMaxOrdered(op, selector, lesser, min)
+		return rs, count
+	}
 	count := 0
 	first := true
 	var rs Result
@@ -37,6 +41,10 @@ func minMaxResPrim[Source, Result any](source Enumerator[Source],
 // element of sequence which produces corresponding projected value (with count of 'source' elements) is returned
 func minMaxElPrim[Source, Result any](source Enumerator[Source],
 	selector func(Source) Result, lesser Lesser[Result], min bool) (Source, int) {
+	if op, ok := source.(orderedPartition[Source]); ok && op.fresh() {
+		re, _, count := minMaxOrdered(op, selector, lesser, min)
+		return re, count
+	}
 	count := 0
 	first := true
 	var re Source
@@ -59,6 +67,27 @@ func minMaxElPrim[Source, Result any](source Enumerator[Source],
 	return re, count
 }
 
+// minMaxOrdered is minMaxElPrim for the not yet enumerated sorted sequence 'op' which avoids the sorting:
+// among the elements producing the equal projected values the element preceding the others
+// in the sorted sequence is chosen (as if the sorted sequence were enumerated)
+// the chosen element with its projected value (and count of elements) is returned
+func minMaxOrdered[Source, Result any](op orderedPartition[Source],
+	selector func(Source) Result, lesser Lesser[Result], min bool) (Source, Result, int) {
+	elel, before := op.elements()
+	if len(elel) == 0 {
+		return ZeroValue[Source](), ZeroValue[Result](), 0
+	}
+	m, rs := 0, selector(elel[0])
+	for i := 1; i < len(elel); i++ {
+		s := selector(elel[i])
+		if (min && lesser.Less(s, rs)) || (!min && lesser.Less(rs, s)) ||
+			(!lesser.Less(s, rs) && !lesser.Less(rs, s) && before(i, m)) {
+			m, rs = i, s
+		}
+	}
+	return elel[m], rs, len(elel)
+}
+
 // Min invokes a transform function on each element of a sequence and returns the minimum resulting value.
 //
 // To get the minimum element of the sequence itself pass Identity as 'selector'.
@@ -73,6 +102,9 @@ func Min[Source, Result any](source Enumerator[Source], selector func(Source) Re
 		return ZeroValue[Result](), ErrNilLesser
 	}
 	min, count := minMaxResPrim(source, selector, lesser, true)
+	if err := Err(source); err != nil {
+		return ZeroValue[Result](), err
+	}
 	if count == 0 {
 		return ZeroValue[Result](), ErrEmptySource
 	}
@@ -101,6 +133,9 @@ func MinEl[Source, Result any](source Enumerator[Source], selector func(Source)
 		return ZeroValue[Source](), ErrNilLesser
 	}
 	min, count := minMaxElPrim(source, selector, lesser, true)
+	if err := Err(source); err != nil {
+		return ZeroValue[Source](), err
+	}
 	if count == 0 {
 		return ZeroValue[Source](), ErrEmptySource
 	}
@@ -130,6 +165,9 @@ func Max[Source, Result any](source Enumerator[Source], selector func(Source) Re
 		return ZeroValue[Result](), ErrNilLesser
 	}
 	max, count := minMaxResPrim(source, selector, lesser, false)
+	if err := Err(source); err != nil {
+		return ZeroValue[Result](), err
+	}
 	if count == 0 {
 		return ZeroValue[Result](), ErrEmptySource
 	}
@@ -158,6 +196,9 @@ func MaxEl[Source, Result any](source Enumerator[Source], selector func(Source)
 		return ZeroValue[Source](), ErrNilLesser
 	}
 	max, count := minMaxElPrim(source, selector, lesser, false)
+	if err := Err(source); err != nil {
+		return ZeroValue[Source](), err
+	}
 	if count == 0 {
 		return ZeroValue[Source](), ErrEmptySource
 	}
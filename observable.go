@@ -0,0 +1,471 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// https://docs.microsoft.com/dotnet/api/system.iobservable-1
+// https://docs.microsoft.com/dotnet/api/system.iobserver-1
+// http://reactivex.io/documentation/observable.html
+
+// Observer receives notifications from an Observable.
+//
+// An Observable calls OnNext zero or more times followed by at most one call of either OnError or OnCompleted.
+// The calls are never concurrent.
+type Observer[T any] interface {
+	// OnNext provides the observer with a new element.
+	OnNext(T)
+	// OnError notifies the observer that the Observable has failed.
+	OnError(error)
+	// OnCompleted notifies the observer that the Observable has finished sending elements.
+	OnCompleted()
+}
+
+// funcObserver is an Observer implementation based on functions
+type funcObserver[T any] struct {
+	onNext      func(T)
+	onError     func(error)
+	onCompleted func()
+}
+
+// NewObserver creates a new Observer based on the provided functions.
+// nil functions are ignored.
+func NewObserver[T any](onNext func(T), onError func(error), onCompleted func()) Observer[T] {
+	return &funcObserver[T]{onNext: onNext, onError: onError, onCompleted: onCompleted}
+}
+
+// OnNext implements the Observer.OnNext method.
+func (o *funcObserver[T]) OnNext(el T) {
+	if o.onNext != nil {
+		o.onNext(el)
+	}
+}
+
+// OnError implements the Observer.OnError method.
+func (o *funcObserver[T]) OnError(err error) {
+	if o.onError != nil {
+		o.onError(err)
+	}
+}
+
+// OnCompleted implements the Observer.OnCompleted method.
+func (o *funcObserver[T]) OnCompleted() {
+	if o.onCompleted != nil {
+		o.onCompleted()
+	}
+}
+
+// Subscription represents an Observer's subscription to an Observable.
+type Subscription interface {
+	// Unsubscribe stops the notifications and releases the resources held by the subscription.
+	// Unsubscribe may be called more than once.
+	Unsubscribe()
+}
+
+// funcSubscription is a Subscription implementation based on a function
+type funcSubscription struct {
+	once        sync.Once
+	unsubscribe func()
+}
+
+// NewSubscription creates a new Subscription that calls 'unsubscribe' once (if 'unsubscribe' is not nil).
+func NewSubscription(unsubscribe func()) Subscription {
+	return &funcSubscription{unsubscribe: unsubscribe}
+}
+
+// Unsubscribe implements the Subscription.Unsubscribe method.
+func (s *funcSubscription) Unsubscribe() {
+	s.once.Do(func() {
+		if s.unsubscribe != nil {
+			s.unsubscribe()
+		}
+	})
+}
+
+// Observable is a push-based sequence
+// (the counterpart of Enumerator, see https://docs.microsoft.com/dotnet/api/system.iobservable-1).
+type Observable[T any] interface {
+	// Subscribe subscribes 'observer' to the Observable's notifications.
+	Subscribe(observer Observer[T]) Subscription
+}
+
+// safeObserver enforces the Observer's grammar and stops the notifications after unsubscription
+type safeObserver[T any] struct {
+	mu      sync.Mutex
+	stopped int32
+	o       Observer[T]
+}
+
+func (so *safeObserver[T]) stop() bool {
+	return atomic.CompareAndSwapInt32(&so.stopped, 0, 1)
+}
+
+func (so *safeObserver[T]) isStopped() bool {
+	return atomic.LoadInt32(&so.stopped) != 0
+}
+
+// OnNext implements the Observer.OnNext method.
+func (so *safeObserver[T]) OnNext(el T) {
+	if so.isStopped() {
+		return
+	}
+	so.mu.Lock()
+	defer so.mu.Unlock()
+	if so.isStopped() {
+		return
+	}
+	so.o.OnNext(el)
+}
+
+// OnError implements the Observer.OnError method.
+func (so *safeObserver[T]) OnError(err error) {
+	so.mu.Lock()
+	defer so.mu.Unlock()
+	if !so.stop() {
+		return
+	}
+	so.o.OnError(err)
+}
+
+// OnCompleted implements the Observer.OnCompleted method.
+func (so *safeObserver[T]) OnCompleted() {
+	so.mu.Lock()
+	defer so.mu.Unlock()
+	if !so.stop() {
+		return
+	}
+	so.o.OnCompleted()
+}
+
+// observableFunc is an Observable implementation based on a subscribe function
+type observableFunc[T any] func(Observer[T]) Subscription
+
+// Subscribe implements the Observable.Subscribe method.
+func (f observableFunc[T]) Subscribe(observer Observer[T]) Subscription {
+	so := &safeObserver[T]{o: observer}
+	s := f(so)
+	return NewSubscription(func() {
+		so.stop()
+		if s != nil {
+			s.Unsubscribe()
+		}
+	})
+}
+
+// NewObservable creates a new Observable based on the provided subscribe function.
+//
+// The Observer passed to 'subscribe' ignores the notifications sent after OnError, OnCompleted or unsubscription,
+// and serializes the concurrent notifications.
+// The Subscription returned by 'subscribe' (may be nil) is unsubscribed when the subscriber unsubscribes.
+func NewObservable[T any](subscribe func(Observer[T]) Subscription) Observable[T] {
+	return observableFunc[T](subscribe)
+}
+
+// Subject is both an Observable and an Observer.
+// Each notification sent to the Subject is broadcast to all its current subscribers.
+type Subject[T any] struct {
+	mu        sync.Mutex
+	observers map[int]Observer[T]
+	nextID    int
+	done      bool
+	err       error
+}
+
+// NewSubject creates a new Subject.
+func NewSubject[T any]() *Subject[T] {
+	return &Subject[T]{observers: make(map[int]Observer[T])}
+}
+
+// Subscribe implements the Observable.Subscribe method.
+// The subscriber of the terminated Subject immediately receives the terminal notification.
+func (s *Subject[T]) Subscribe(observer Observer[T]) Subscription {
+	return NewObservable(func(o Observer[T]) Subscription {
+		s.mu.Lock()
+		if s.done {
+			err := s.err
+			s.mu.Unlock()
+			if err != nil {
+				o.OnError(err)
+			} else {
+				o.OnCompleted()
+			}
+			return nil
+		}
+		id := s.nextID
+		s.nextID++
+		s.observers[id] = o
+		s.mu.Unlock()
+		return NewSubscription(func() {
+			s.mu.Lock()
+			delete(s.observers, id)
+			s.mu.Unlock()
+		})
+	}).Subscribe(observer)
+}
+
+// snapshot returns the current observers
+func (s *Subject[T]) snapshot(terminate bool, err error) []Observer[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.done {
+		return nil
+	}
+	oo := make([]Observer[T], 0, len(s.observers))
+	for _, o := range s.observers {
+		oo = append(oo, o)
+	}
+	if terminate {
+		s.done = true
+		s.err = err
+		s.observers = nil
+	}
+	return oo
+}
+
+// OnNext implements the Observer.OnNext method.
+func (s *Subject[T]) OnNext(el T) {
+	for _, o := range s.snapshot(false, nil) {
+		o.OnNext(el)
+	}
+}
+
+// OnError implements the Observer.OnError method.
+func (s *Subject[T]) OnError(err error) {
+	for _, o := range s.snapshot(true, err) {
+		o.OnError(err)
+	}
+}
+
+// OnCompleted implements the Observer.OnCompleted method.
+func (s *Subject[T]) OnCompleted() {
+	for _, o := range s.snapshot(true, nil) {
+		o.OnCompleted()
+	}
+}
+
+// ToObservable converts an Enumerator to an Observable.
+//
+// Each subscription enumerates 'en' (starting from the current position) in a separate goroutine
+// and sends its elements to the subscriber. If the subscriber unsubscribes 'en' is closed (see Close).
+// Since 'en' is shared by the subscriptions, the resulting Observable is intended for a single subscription.
+func ToObservable[T any](en Enumerator[T]) (Observable[T], error) {
+	if en == nil {
+		return nil, ErrNilSource
+	}
+	return NewObservable(func(o Observer[T]) Subscription {
+		done := make(chan struct{})
+		go func() {
+			for en.MoveNext() {
+				select {
+				case <-done:
+					Close(en)
+					return
+				default:
+					o.OnNext(en.Current())
+				}
+			}
+			if err := Err(en); err != nil {
+				o.OnError(err)
+				return
+			}
+			o.OnCompleted()
+		}()
+		return NewSubscription(func() { close(done) })
+	}), nil
+}
+
+// ToObservableMust is like ToObservable but panics in case of error.
+func ToObservableMust[T any](en Enumerator[T]) Observable[T] {
+	r, err := ToObservable(en)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ChanToObservable converts a channel to an Observable.
+//
+// Each subscription receives the elements from 'ch' in a separate goroutine and sends them to the subscriber.
+// The subscriber is completed when 'ch' is closed.
+func ChanToObservable[T any](ch <-chan T) (Observable[T], error) {
+	if ch == nil {
+		return nil, ErrNilSource
+	}
+	return NewObservable(func(o Observer[T]) Subscription {
+		done := make(chan struct{})
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				case el, open := <-ch:
+					if !open {
+						o.OnCompleted()
+						return
+					}
+					o.OnNext(el)
+				}
+			}
+		}()
+		return NewSubscription(func() { close(done) })
+	}), nil
+}
+
+// ChanToObservableMust is like ChanToObservable but panics in case of error.
+func ChanToObservableMust[T any](ch <-chan T) Observable[T] {
+	r, err := ChanToObservable(ch)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// notification is an Observer's notification
+type notification[T any] struct {
+	el  T
+	err error
+}
+
+// subscribeAsync subscribes 'observer' to 'source' in a separate goroutine,
+// so a source sending notifications synchronously within Subscribe does not block the caller.
+// subscribeAsync returns when Subscribe returns or when the first notification is sent, whichever comes first.
+// The returned Subscription may be unsubscribed before Subscribe returns.
+func subscribeAsync[T any](source Observable[T], observer Observer[T]) Subscription {
+	ss := &subscriptionSet{}
+	started := make(chan struct{})
+	var startOnce sync.Once
+	start := func() { startOnce.Do(func() { close(started) }) }
+	go func() {
+		ss.add(source.Subscribe(NewObserver(
+			func(el T) { start(); observer.OnNext(el) },
+			func(err error) { start(); observer.OnError(err) },
+			func() { start(); observer.OnCompleted() },
+		)))
+		start()
+	}()
+	<-started
+	return NewSubscription(ss.unsubscribe)
+}
+
+// ObservableToEnumerator subscribes to 'source' and converts the received notifications to an Enumerator.
+//
+// The elements are buffered in a channel of the specified size,
+// the Observable is blocked when the buffer is full (back-pressure).
+// The error sent by 'source' is returned by the Err method of the resulting Enumerator (see ErrEnumerator).
+// Close the resulting Enumerator to unsubscribe from 'source' (see Close).
+// The resulting Enumerator cannot be reset (see OnChan.Reset).
+// 'source' is subscribed to in a separate goroutine, so 'source' may send more than 'buffer' notifications
+// synchronously within Subscribe.
+func ObservableToEnumerator[T any](source Observable[T], buffer int) (Enumerator[T], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if buffer < 0 {
+		return nil, ErrNegativeCount
+	}
+	ch := make(chan notification[T], buffer)
+	closed := make(chan struct{})
+	send := func(n notification[T]) {
+		select {
+		case <-closed:
+		case ch <- n:
+		}
+	}
+	sub := subscribeAsync(source, NewObserver(
+		func(el T) { send(notification[T]{el: el}) },
+		func(err error) { send(notification[T]{err: err}); close(ch) },
+		func() { close(ch) },
+	))
+	var c T
+	var err error
+	var closeOnce sync.Once
+	return OnFunc[T]{
+		mvNxt: func() bool {
+			if err != nil {
+				return false
+			}
+			select {
+			case <-closed:
+				return false
+			case n, open := <-ch:
+				if !open {
+					return false
+				}
+				if n.err != nil {
+					err = n.err
+					return false
+				}
+				c = n.el
+				return true
+			}
+		},
+		crrnt: func() T { return c },
+		err:   func() error { return err },
+		cls: func() error {
+			closeOnce.Do(func() {
+				close(closed)
+				sub.Unsubscribe()
+			})
+			return nil
+		},
+	}, nil
+}
+
+// ObservableToEnumeratorMust is like ObservableToEnumerator but panics in case of error.
+func ObservableToEnumeratorMust[T any](source Observable[T], buffer int) Enumerator[T] {
+	r, err := ObservableToEnumerator(source, buffer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ObservableToChan subscribes to 'source' and sends the received elements to a channel of the specified size.
+//
+// The channel is closed when 'source' completes or fails (the error is discarded, see ObservableToEnumerator)
+// or after unsubscription.
+func ObservableToChan[T any](source Observable[T], buffer int) (<-chan T, Subscription, error) {
+	if source == nil {
+		return nil, nil, ErrNilSource
+	}
+	if buffer < 0 {
+		return nil, nil, ErrNegativeCount
+	}
+	ch := make(chan T, buffer)
+	done := make(chan struct{})
+	// the senders hold mu for reading, so the channel is closed when there are no pending sends
+	var mu sync.RWMutex
+	closed := false
+	closeCh := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if !closed {
+			closed = true
+			close(ch)
+		}
+	}
+	sub := subscribeAsync(source, NewObserver(
+		func(el T) {
+			mu.RLock()
+			defer mu.RUnlock()
+			if closed {
+				return
+			}
+			select {
+			case <-done:
+			case ch <- el:
+			}
+		},
+		func(error) { closeCh() },
+		closeCh,
+	))
+	var doneOnce sync.Once
+	return ch, NewSubscription(func() {
+		sub.Unsubscribe()
+		doneOnce.Do(func() { close(done) })
+		closeCh()
+	}), nil
+}
@@ -0,0 +1,298 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recorder is an Observer that records the notifications
+type recorder[T any] struct {
+	mu        sync.Mutex
+	els       []T
+	err       error
+	completed bool
+	done      chan struct{}
+}
+
+func newRecorder[T any]() *recorder[T] {
+	return &recorder[T]{done: make(chan struct{})}
+}
+
+func (r *recorder[T]) OnNext(el T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.els = append(r.els, el)
+}
+
+func (r *recorder[T]) OnError(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.err = err
+	close(r.done)
+}
+
+func (r *recorder[T]) OnCompleted() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.completed = true
+	close(r.done)
+}
+
+func (r *recorder[T]) elements() []T {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]T(nil), r.els...)
+}
+
+func (r *recorder[T]) wait(t *testing.T) {
+	select {
+	case <-r.done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Observable did not terminate")
+	}
+}
+
+func TestSubject_WhereObs_SelectObs(t *testing.T) {
+	subj := NewSubject[int]()
+	obs := SelectObsMust(WhereObsMust[int](subj, func(i int) bool { return i%2 == 0 }),
+		func(i int) int { return i * 10 })
+	rec := newRecorder[int]()
+	sub := obs.Subscribe(rec)
+	for i := 1; i <= 4; i++ {
+		subj.OnNext(i)
+	}
+	sub.Unsubscribe()
+	subj.OnNext(6)
+	if got, want := rec.elements(), []int{20, 40}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SelectObs(WhereObs()) = '%v', want '%v'", got, want)
+	}
+	subj.OnCompleted()
+	if rec.completed {
+		t.Errorf("unsubscribed Observer has been completed")
+	}
+	late := newRecorder[int]()
+	subj.Subscribe(late)
+	if !late.completed {
+		t.Errorf("late subscriber of completed Subject has not been completed")
+	}
+}
+
+func TestBufferObs_int(t *testing.T) {
+	rec := newRecorder[[]int]()
+	BufferObsMust(ToObservableMust(RangeMust(1, 7)), 3).Subscribe(rec)
+	rec.wait(t)
+	if got, want := rec.elements(), [][]int{{1, 2, 3}, {4, 5, 6}, {7}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("BufferObs() = '%v', want '%v'", got, want)
+	}
+	if _, err := BufferObs[int](NewSubject[int](), 0); err != ErrSizeOutOfRange {
+		t.Errorf("BufferObs() error = '%v', want '%v'", err, ErrSizeOutOfRange)
+	}
+}
+
+func TestThrottleObs_DebounceObs_SampleObs(t *testing.T) {
+	// events at 0ms, 10ms, 20ms, 100ms, 105ms, 300ms
+	type event struct {
+		after time.Duration
+		el    int
+	}
+	events := []event{{0, 1}, {10 * time.Millisecond, 2}, {10 * time.Millisecond, 3},
+		{80 * time.Millisecond, 4}, {5 * time.Millisecond, 5}, {195 * time.Millisecond, 6}}
+	tests := []struct {
+		name string
+		op   func(Observable[int], Clock) Observable[int]
+		want []int
+	}{
+		{name: "Throttle",
+			op:   func(o Observable[int], c Clock) Observable[int] { return ThrottleObsMust(o, 50*time.Millisecond, c) },
+			want: []int{1, 4, 6},
+		},
+		{name: "Debounce",
+			op:   func(o Observable[int], c Clock) Observable[int] { return DebounceObsMust(o, 50*time.Millisecond, c) },
+			want: []int{3, 5, 6},
+		},
+		{name: "Sample",
+			op:   func(o Observable[int], c Clock) Observable[int] { return SampleObsMust(o, 50*time.Millisecond, c) },
+			want: []int{3, 5, 6},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := NewManualClock(time.Unix(0, 0))
+			subj := NewSubject[int]()
+			rec := newRecorder[int]()
+			sub := tt.op(subj, clock).Subscribe(rec)
+			for _, e := range events {
+				clock.Advance(e.after)
+				subj.OnNext(e.el)
+			}
+			clock.Advance(time.Second)
+			sub.Unsubscribe()
+			if got := rec.elements(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%sObs() = '%v', want '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBufferTimeObs_int(t *testing.T) {
+	clock := NewManualClock(time.Unix(0, 0))
+	subj := NewSubject[int]()
+	rec := newRecorder[[]int]()
+	BufferTimeObsMust[int](subj, time.Second, clock).Subscribe(rec)
+	subj.OnNext(1)
+	subj.OnNext(2)
+	clock.Advance(time.Second)
+	clock.Advance(time.Second)
+	subj.OnNext(3)
+	subj.OnCompleted()
+	if got, want := rec.elements(), [][]int{{1, 2}, {3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("BufferTimeObs() = '%v', want '%v'", got, want)
+	}
+	if !rec.completed {
+		t.Errorf("BufferTimeObs() has not been completed")
+	}
+	if _, err := BufferTimeObs[int](subj, 0, clock); err != ErrDurationOutOfRange {
+		t.Errorf("BufferTimeObs() error = '%v', want '%v'", err, ErrDurationOutOfRange)
+	}
+}
+
+func TestMergeObs_CombineLatestObs(t *testing.T) {
+	s1, s2 := NewSubject[int](), NewSubject[int]()
+	merged := newRecorder[int]()
+	MergeObsMust[int](s1, s2).Subscribe(merged)
+	combined := newRecorder[int]()
+	CombineLatestObsMust[int, int, int](s1, s2, func(i1, i2 int) int { return i1*10 + i2 }).Subscribe(combined)
+	s1.OnNext(1)
+	s2.OnNext(2)
+	s1.OnNext(3)
+	s1.OnCompleted()
+	s2.OnNext(4)
+	if merged.completed || combined.completed {
+		t.Errorf("Observables completed before all the sources have completed")
+	}
+	s2.OnCompleted()
+	if got, want := merged.elements(), []int{1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeObs() = '%v', want '%v'", got, want)
+	}
+	if got, want := combined.elements(), []int{12, 32, 34}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CombineLatestObs() = '%v', want '%v'", got, want)
+	}
+	if !merged.completed || !combined.completed {
+		t.Errorf("Observables have not been completed")
+	}
+}
+
+// subjectObservers returns the number of the current subscribers of 's'
+func subjectObservers[T any](s *Subject[T]) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.observers)
+}
+
+func TestMergeObs_CombineLatestObs_errorUnsubscribes(t *testing.T) {
+	// the synchronous error: the first source has already failed
+	failed, live := NewSubject[int](), NewSubject[int]()
+	failed.OnError(errTest)
+	merged := newRecorder[int]()
+	MergeObsMust[int](failed, live).Subscribe(merged)
+	combined := newRecorder[int]()
+	CombineLatestObsMust[int, int, int](failed, live, func(i1, i2 int) int { return i1 + i2 }).Subscribe(combined)
+	if merged.err != errTest || combined.err != errTest {
+		t.Errorf("errors = '%v', '%v', want '%v'", merged.err, combined.err, errTest)
+	}
+	if n := subjectObservers(live); n != 0 {
+		t.Errorf("the live source has %d subscribers after the error, want 0", n)
+	}
+
+	// the asynchronous error
+	s1, s2 := NewSubject[int](), NewSubject[int]()
+	MergeObsMust[int](s1, s2).Subscribe(newRecorder[int]())
+	CombineLatestObsMust[int, int, int](s1, s2, func(i1, i2 int) int { return i1 + i2 }).Subscribe(newRecorder[int]())
+	s1.OnError(errTest)
+	if n := subjectObservers(s2); n != 0 {
+		t.Errorf("the live source has %d subscribers after the error, want 0", n)
+	}
+}
+
+func TestObservableToEnumerator_errors(t *testing.T) {
+	obs := ToObservableMust[int](failAt(RangeMust(1, 10), 4))
+	en := ObservableToEnumeratorMust(obs, 1)
+	got := Slice(en)
+	err := Err(en)
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ObservableToEnumerator() = '%v', want '%v'", got, want)
+	}
+	if err != errTest {
+		t.Errorf("ObservableToEnumerator() error = '%v', want '%v'", err, errTest)
+	}
+}
+
+// syncObservable sends the elements synchronously within Subscribe
+func syncObservable(n int) Observable[int] {
+	return NewObservable(func(o Observer[int]) Subscription {
+		for i := 0; i < n; i++ {
+			o.OnNext(i)
+		}
+		o.OnCompleted()
+		return NewSubscription(func() {})
+	})
+}
+
+func TestObservableToEnumerator_sync(t *testing.T) {
+	en := ObservableToEnumeratorMust(syncObservable(5), 2)
+	if got, want := Slice(en), []int{0, 1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ObservableToEnumerator() = '%v', want '%v'", got, want)
+	}
+	// Close unblocks the synchronous source abandoned before the end
+	en = ObservableToEnumeratorMust(syncObservable(100), 2)
+	if !en.MoveNext() || en.Current() != 0 {
+		t.Fatalf("ObservableToEnumerator().MoveNext() failed")
+	}
+	Close(en)
+}
+
+func TestObservableToChan_sync(t *testing.T) {
+	out, sub, err := ObservableToChan(syncObservable(5), 2)
+	if err != nil {
+		t.Fatalf("ObservableToChan() error = '%v'", err)
+	}
+	defer sub.Unsubscribe()
+	if got, want := Slice[int](NewOnChan(out)), []int{0, 1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ObservableToChan() = '%v', want '%v'", got, want)
+	}
+}
+
+func TestObservableToChan_ChanToObservable(t *testing.T) {
+	ch := ToChanMust(context.Background(), RangeMust(1, 5), 0)
+	out, sub, err := ObservableToChan(ChanToObservableMust(ch), 2)
+	if err != nil {
+		t.Fatalf("ObservableToChan() error = '%v'", err)
+	}
+	defer sub.Unsubscribe()
+	if got, want := Slice[int](NewOnChan(out)), []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ObservableToChan(ChanToObservable()) = '%v', want '%v'", got, want)
+	}
+}
+
+func TestObservableToEnumerator_Close(t *testing.T) {
+	subj := NewSubject[int]()
+	en := ObservableToEnumeratorMust[int](subj, 0)
+	go func() {
+		for i := 0; i < 3; i++ {
+			subj.OnNext(i)
+		}
+	}()
+	if !en.MoveNext() || en.Current() != 0 {
+		t.Fatalf("ObservableToEnumerator().MoveNext() failed")
+	}
+	// Close unblocks the Subject and unsubscribes from it
+	Close(en)
+	if en.MoveNext() {
+		t.Errorf("ObservableToEnumerator().MoveNext() after Close = true, want false")
+	}
+}
@@ -0,0 +1,490 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// http://reactivex.io/documentation/operators.html
+
+// WhereObs filters the elements of an Observable based on a predicate.
+func WhereObs[Source any](source Observable[Source], predicate func(Source) bool) (Observable[Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if predicate == nil {
+		return nil, ErrNilPredicate
+	}
+	return NewObservable(func(o Observer[Source]) Subscription {
+		return source.Subscribe(NewObserver(
+			func(el Source) {
+				if predicate(el) {
+					o.OnNext(el)
+				}
+			},
+			o.OnError,
+			o.OnCompleted,
+		))
+	}), nil
+}
+
+// WhereObsMust is like WhereObs but panics in case of error.
+func WhereObsMust[Source any](source Observable[Source], predicate func(Source) bool) Observable[Source] {
+	r, err := WhereObs(source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SelectObs projects each element of an Observable into a new form.
+func SelectObs[Source, Result any](source Observable[Source], selector func(Source) Result) (Observable[Result], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if selector == nil {
+		return nil, ErrNilSelector
+	}
+	return NewObservable(func(o Observer[Result]) Subscription {
+		return source.Subscribe(NewObserver(
+			func(el Source) { o.OnNext(selector(el)) },
+			o.OnError,
+			o.OnCompleted,
+		))
+	}), nil
+}
+
+// SelectObsMust is like SelectObs but panics in case of error.
+func SelectObsMust[Source, Result any](source Observable[Source], selector func(Source) Result) Observable[Result] {
+	r, err := SelectObs(source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// BufferObs collects the elements of an Observable into buffers of the specified size.
+// The last buffer (sent before the completion) may be smaller.
+func BufferObs[Source any](source Observable[Source], count int) (Observable[[]Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if count <= 0 {
+		return nil, ErrSizeOutOfRange
+	}
+	return NewObservable(func(o Observer[[]Source]) Subscription {
+		var buf []Source
+		return source.Subscribe(NewObserver(
+			func(el Source) {
+				buf = append(buf, el)
+				if len(buf) == count {
+					o.OnNext(buf)
+					buf = nil
+				}
+			},
+			o.OnError,
+			func() {
+				if len(buf) > 0 {
+					o.OnNext(buf)
+				}
+				o.OnCompleted()
+			},
+		))
+	}), nil
+}
+
+// BufferObsMust is like BufferObs but panics in case of error.
+func BufferObsMust[Source any](source Observable[Source], count int) Observable[[]Source] {
+	r, err := BufferObs(source, count)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// timedObserver holds the state shared by the time-based operators' notifications and timers
+type timedObserver struct {
+	mu      sync.Mutex
+	stopped int32
+}
+
+// unsubscribe stops the timers' actions
+// (the timers themselves are not stopped, so unsubscribe may be safely called from the notifications)
+func (to *timedObserver) unsubscribe() {
+	atomic.StoreInt32(&to.stopped, 1)
+}
+
+// do calls 'f' under the lock unless the subscription has been unsubscribed
+func (to *timedObserver) do(f func()) {
+	to.mu.Lock()
+	defer to.mu.Unlock()
+	if atomic.LoadInt32(&to.stopped) != 0 {
+		return
+	}
+	f()
+}
+
+// every calls 'f' every 'd' according to 'clock' until the subscription is unsubscribed
+func (to *timedObserver) every(clock Clock, d time.Duration, f func()) {
+	var tick func()
+	tick = func() {
+		if atomic.LoadInt32(&to.stopped) != 0 {
+			return
+		}
+		to.do(f)
+		clock.AfterFunc(d, tick)
+	}
+	clock.AfterFunc(d, tick)
+}
+
+// timedSubscription subscribes to 'source' and unsubscribes 'to' along with the source's subscription
+func timedSubscription[Source any](to *timedObserver, source Observable[Source], observer Observer[Source]) Subscription {
+	s := source.Subscribe(observer)
+	return NewSubscription(func() {
+		to.unsubscribe()
+		s.Unsubscribe()
+	})
+}
+
+// validateTimed checks the time-based operators' arguments
+func validateTimed[Source any](source Observable[Source], d time.Duration, clock Clock) (Clock, error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if d <= 0 {
+		return nil, ErrDurationOutOfRange
+	}
+	if clock == nil {
+		return SystemClock, nil
+	}
+	return clock, nil
+}
+
+// BufferTimeObs collects the elements of an Observable into buffers that are sent every 'd' according to 'clock'.
+// Empty buffers are not sent. SystemClock is used if 'clock' is nil.
+func BufferTimeObs[Source any](source Observable[Source], d time.Duration, clock Clock) (Observable[[]Source], error) {
+	clock, err := validateTimed(source, d, clock)
+	if err != nil {
+		return nil, err
+	}
+	return NewObservable(func(o Observer[[]Source]) Subscription {
+		to := &timedObserver{}
+		var buf []Source
+		flush := func() {
+			if len(buf) > 0 {
+				o.OnNext(buf)
+				buf = nil
+			}
+		}
+		to.every(clock, d, flush)
+		return timedSubscription(to, source, NewObserver(
+			func(el Source) { to.do(func() { buf = append(buf, el) }) },
+			func(err error) { to.do(func() { o.OnError(err); to.unsubscribe() }) },
+			func() { to.do(func() { flush(); o.OnCompleted(); to.unsubscribe() }) },
+		))
+	}), nil
+}
+
+// BufferTimeObsMust is like BufferTimeObs but panics in case of error.
+func BufferTimeObsMust[Source any](source Observable[Source], d time.Duration, clock Clock) Observable[[]Source] {
+	r, err := BufferTimeObs(source, d, clock)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ThrottleObs sends an element of an Observable and then ignores the following elements for 'd' according to 'clock'.
+// SystemClock is used if 'clock' is nil.
+func ThrottleObs[Source any](source Observable[Source], d time.Duration, clock Clock) (Observable[Source], error) {
+	clock, err := validateTimed(source, d, clock)
+	if err != nil {
+		return nil, err
+	}
+	return NewObservable(func(o Observer[Source]) Subscription {
+		var next time.Time
+		started := false
+		return source.Subscribe(NewObserver(
+			func(el Source) {
+				now := clock.Now()
+				if started && now.Before(next) {
+					return
+				}
+				started = true
+				next = now.Add(d)
+				o.OnNext(el)
+			},
+			o.OnError,
+			o.OnCompleted,
+		))
+	}), nil
+}
+
+// ThrottleObsMust is like ThrottleObs but panics in case of error.
+func ThrottleObsMust[Source any](source Observable[Source], d time.Duration, clock Clock) Observable[Source] {
+	r, err := ThrottleObs(source, d, clock)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// DebounceObs sends an element of an Observable only if no other element has been received for 'd' according to 'clock'.
+// The pending element is sent on the completion. SystemClock is used if 'clock' is nil.
+func DebounceObs[Source any](source Observable[Source], d time.Duration, clock Clock) (Observable[Source], error) {
+	clock, err := validateTimed(source, d, clock)
+	if err != nil {
+		return nil, err
+	}
+	return NewObservable(func(o Observer[Source]) Subscription {
+		to := &timedObserver{}
+		var last Source
+		pending := false
+		gen := 0
+		return timedSubscription(to, source, NewObserver(
+			func(el Source) {
+				to.do(func() {
+					last, pending = el, true
+					gen++
+					g := gen
+					clock.AfterFunc(d, func() {
+						to.do(func() {
+							if g == gen && pending {
+								pending = false
+								o.OnNext(last)
+							}
+						})
+					})
+				})
+			},
+			func(err error) { to.do(func() { o.OnError(err); to.unsubscribe() }) },
+			func() {
+				to.do(func() {
+					if pending {
+						pending = false
+						o.OnNext(last)
+					}
+					o.OnCompleted()
+					to.unsubscribe()
+				})
+			},
+		))
+	}), nil
+}
+
+// DebounceObsMust is like DebounceObs but panics in case of error.
+func DebounceObsMust[Source any](source Observable[Source], d time.Duration, clock Clock) Observable[Source] {
+	r, err := DebounceObs(source, d, clock)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SampleObs sends the latest element of an Observable received since the previous sampling,
+// the sampling occurs every 'd' according to 'clock'. SystemClock is used if 'clock' is nil.
+func SampleObs[Source any](source Observable[Source], d time.Duration, clock Clock) (Observable[Source], error) {
+	clock, err := validateTimed(source, d, clock)
+	if err != nil {
+		return nil, err
+	}
+	return NewObservable(func(o Observer[Source]) Subscription {
+		to := &timedObserver{}
+		var last Source
+		has := false
+		to.every(clock, d, func() {
+			if has {
+				has = false
+				o.OnNext(last)
+			}
+		})
+		return timedSubscription(to, source, NewObserver(
+			func(el Source) { to.do(func() { last, has = el, true }) },
+			func(err error) { to.do(func() { o.OnError(err); to.unsubscribe() }) },
+			func() { to.do(func() { o.OnCompleted(); to.unsubscribe() }) },
+		))
+	}), nil
+}
+
+// SampleObsMust is like SampleObs but panics in case of error.
+func SampleObsMust[Source any](source Observable[Source], d time.Duration, clock Clock) Observable[Source] {
+	r, err := SampleObs(source, d, clock)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// subscriptionSet holds the subscriptions to the sources of a combined Observable.
+// Once the set is unsubscribed, the subscriptions added later are unsubscribed immediately
+// (a source may fail before all the sources have been subscribed).
+type subscriptionSet struct {
+	mu      sync.Mutex
+	subs    []Subscription
+	stopped bool
+}
+
+// add adds 's' to the set or unsubscribes it if the set has been unsubscribed
+func (ss *subscriptionSet) add(s Subscription) {
+	if s == nil {
+		return
+	}
+	ss.mu.Lock()
+	if ss.stopped {
+		ss.mu.Unlock()
+		s.Unsubscribe()
+		return
+	}
+	ss.subs = append(ss.subs, s)
+	ss.mu.Unlock()
+}
+
+// isStopped reports whether the set has been unsubscribed
+func (ss *subscriptionSet) isStopped() bool {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	return ss.stopped
+}
+
+// unsubscribe unsubscribes all the subscriptions of the set
+func (ss *subscriptionSet) unsubscribe() {
+	ss.mu.Lock()
+	subs := ss.subs
+	ss.subs, ss.stopped = nil, true
+	ss.mu.Unlock()
+	for _, s := range subs {
+		s.Unsubscribe()
+	}
+}
+
+// MergeObs merges the elements of several Observables into one Observable.
+// The resulting Observable completes when all the sources complete and fails when any source fails.
+func MergeObs[Source any](sources ...Observable[Source]) (Observable[Source], error) {
+	for _, source := range sources {
+		if source == nil {
+			return nil, ErrNilSource
+		}
+	}
+	return NewObservable(func(o Observer[Source]) Subscription {
+		var mu sync.Mutex
+		active := len(sources)
+		if active == 0 {
+			o.OnCompleted()
+			return nil
+		}
+		ss := &subscriptionSet{}
+		for _, source := range sources {
+			if ss.isStopped() {
+				break
+			}
+			ss.add(source.Subscribe(NewObserver(
+				o.OnNext,
+				func(err error) {
+					o.OnError(err)
+					ss.unsubscribe()
+				},
+				func() {
+					mu.Lock()
+					active--
+					completed := active == 0
+					mu.Unlock()
+					if completed {
+						o.OnCompleted()
+					}
+				},
+			)))
+		}
+		return NewSubscription(ss.unsubscribe)
+	}), nil
+}
+
+// MergeObsMust is like MergeObs but panics in case of error.
+func MergeObsMust[Source any](sources ...Observable[Source]) Observable[Source] {
+	r, err := MergeObs(sources...)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// CombineLatestObs combines the latest elements of two Observables using 'combiner'
+// each time either Observable sends an element (once both Observables have sent at least one element).
+// The resulting Observable completes when both sources complete and fails when either source fails.
+func CombineLatestObs[First, Second, Result any](first Observable[First], second Observable[Second],
+	combiner func(First, Second) Result) (Observable[Result], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if combiner == nil {
+		return nil, ErrNilSelector
+	}
+	return NewObservable(func(o Observer[Result]) Subscription {
+		var mu sync.Mutex
+		var f First
+		var s Second
+		hasF, hasS := false, false
+		active := 2
+		complete := func() {
+			mu.Lock()
+			active--
+			completed := active == 0
+			mu.Unlock()
+			if completed {
+				o.OnCompleted()
+			}
+		}
+		ss := &subscriptionSet{}
+		// the failure of either source unsubscribes the other one
+		onError := func(err error) {
+			o.OnError(err)
+			ss.unsubscribe()
+		}
+		ss.add(first.Subscribe(NewObserver(
+			func(el First) {
+				mu.Lock()
+				f, hasF = el, true
+				if !hasS {
+					mu.Unlock()
+					return
+				}
+				r := combiner(f, s)
+				mu.Unlock()
+				o.OnNext(r)
+			},
+			onError,
+			complete,
+		)))
+		if ss.isStopped() {
+			return NewSubscription(ss.unsubscribe)
+		}
+		ss.add(second.Subscribe(NewObserver(
+			func(el Second) {
+				mu.Lock()
+				s, hasS = el, true
+				if !hasF {
+					mu.Unlock()
+					return
+				}
+				r := combiner(f, s)
+				mu.Unlock()
+				o.OnNext(r)
+			},
+			onError,
+			complete,
+		)))
+		return NewSubscription(ss.unsubscribe)
+	}), nil
+}
+
+// CombineLatestObsMust is like CombineLatestObs but panics in case of error.
+func CombineLatestObsMust[First, Second, Result any](first Observable[First], second Observable[Second],
+	combiner func(First, Second) Result) Observable[Result] {
+	r, err := CombineLatestObs(first, second, combiner)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -2,6 +2,10 @@
 
 package go2linq
 
+import (
+	"context"
+)
+
 // OnChan is an Enumerator implementation based on a channel.
 type OnChan[T any] struct {
 	chn   <-chan T
@@ -38,3 +42,52 @@ func (en *OnChan[T]) Current() T {
 // OnChan.Reset method does nothing. Hence OnChan cannot be used in functions
 // that require an Enumerator with a real Reset method (see ConcatSelf and the like).
 func (*OnChan[T]) Reset() {}
+
+// OnChanCtx is an Enumerator implementation based on a channel and a context.
+// OnChanCtx implements the ErrEnumerator interface.
+type OnChanCtx[T any] struct {
+	ctx   context.Context
+	chn   <-chan T
+	crrnt T
+	err   error
+}
+
+// NewOnChanCtx creates a new OnChanCtx based on the provided context and channel.
+// MoveNext returns false if 'ctx' is done before the next element is received,
+// the context's error is then returned by the Err method.
+func NewOnChanCtx[T any](ctx context.Context, ch <-chan T) *OnChanCtx[T] {
+	return &OnChanCtx[T]{ctx: ctx, chn: ch}
+}
+
+// MoveNext implements the Enumerator.MoveNext method.
+func (en *OnChanCtx[T]) MoveNext() bool {
+	if en.chn == nil || en.err != nil {
+		return false
+	}
+	select {
+	case <-en.ctx.Done():
+		en.err = en.ctx.Err()
+		return false
+	case c, open := <-en.chn:
+		if !open {
+			return false
+		}
+		en.crrnt = c
+		return true
+	}
+}
+
+// Current implements the Enumerator.Current method.
+func (en *OnChanCtx[T]) Current() T {
+	return en.crrnt
+}
+
+// Reset implements the Enumerator.Reset method.
+//
+// OnChanCtx.Reset method does nothing (see OnChan.Reset).
+func (*OnChanCtx[T]) Reset() {}
+
+// Err implements the ErrEnumerator.Err method.
+func (en *OnChanCtx[T]) Err() error {
+	return en.err
+}
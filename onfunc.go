@@ -7,11 +7,26 @@ type OnFunc[T any] struct {
 	mvNxt func() bool
 	crrnt func() T
 	rst   func()
+	err   func() error
+	cls   func() error
 }
 
 // NewOnFunc creates a new OnFunc based on the provided functions.
 func NewOnFunc[T any](mvNxt func() bool, crrnt func() T, rst func()) OnFunc[T] {
-	return OnFunc[T]{mvNxt, crrnt, rst}
+	return OnFunc[T]{mvNxt: mvNxt, crrnt: crrnt, rst: rst}
+}
+
+// NewOnFuncErr creates a new OnFunc based on the provided functions.
+// 'err' reports the error encountered during iteration (see ErrEnumerator).
+func NewOnFuncErr[T any](mvNxt func() bool, crrnt func() T, rst func(), err func() error) OnFunc[T] {
+	return OnFunc[T]{mvNxt: mvNxt, crrnt: crrnt, rst: rst, err: err}
+}
+
+// NewOnFuncErrClose creates a new OnFunc based on the provided functions.
+// 'err' reports the error encountered during iteration (see ErrEnumerator),
+// 'cls' releases the resources held by the enumerator (see io.Closer).
+func NewOnFuncErrClose[T any](mvNxt func() bool, crrnt func() T, rst func(), err func() error, cls func() error) OnFunc[T] {
+	return OnFunc[T]{mvNxt, crrnt, rst, err, cls}
 }
 
 // NewOnFuncEn creates a new Enumerator based on the corresponding OnFunc.
@@ -42,3 +57,19 @@ func (en OnFunc[T]) Reset() {
 	}
 	en.rst()
 }
+
+// Err implements the ErrEnumerator.Err method.
+func (en OnFunc[T]) Err() error {
+	if en.err == nil {
+		return nil
+	}
+	return en.err()
+}
+
+// Close implements the io.Closer interface.
+func (en OnFunc[T]) Close() error {
+	if en.cls == nil {
+		return nil
+	}
+	return en.cls()
+}
@@ -3,7 +3,7 @@
 package go2linq
 
 import (
-	"sync"
+	"sort"
 )
 
 func NewOnMapImmediate[Key comparable, Element any](m map[Key]Element) Enumerator[KeyElement[Key, Element]] {
@@ -15,41 +15,57 @@ func NewOnMapImmediate[Key comparable, Element any](m map[Key]Element) Enumerato
 }
 
 // OnMap is an Enumerator implementation based on map[Key]Element.
+//
+// OnMap takes a snapshot of the map's keys on the first call to MoveNext (and on the first call after Reset).
+// Elements are looked up in the map when OnMap moves to them,
+// so keys removed from the map after the snapshot has been taken are skipped
+// and keys added to the map after the snapshot has been taken are not enumerated.
 type OnMap[Key comparable, Element any] struct {
-	mp       map[Key]Element
-	closed   bool
-	closedCh chan struct{}
-	closeMtx sync.Mutex
-	crrntCh  chan KeyElement[Key, Element]
-	crrnt    KeyElement[Key, Element]
+	mp map[Key]Element
+	// ls - if not nil, keys are enumerated in the order defined by ls
+	ls Lesser[Key]
+	// kk - snapshot of the map's keys, nil if the snapshot has not been taken yet
+	kk []Key
+	// indx-1 - index of the current key in kk
+	indx   int
+	closed bool
+	crrnt  KeyElement[Key, Element]
 }
 
-func rangeOnMap[Key comparable, Element any](om *OnMap[Key, Element]) {
-	for k, e := range om.mp {
-		if om.closed {
-			return
-		}
-		select {
-		case <-om.closedCh:
-			return
-		case om.crrntCh <- KeyElement[Key, Element]{key: k, element: e}:
-		}
+// NewOnMap creates a new OnMap based on the provided map.
+// The keys are enumerated in unspecified order.
+func NewOnMap[Key comparable, Element any](m map[Key]Element) *OnMap[Key, Element] {
+	return &OnMap[Key, Element]{mp: m}
+}
+
+// NewOnMapSorted creates a new OnMap based on the provided map.
+// The keys are enumerated in the order defined by 'lesser'.
+// (Use Order[Key]{} as 'lesser' for ordered key types.)
+func NewOnMapSorted[Key comparable, Element any](m map[Key]Element, lesser Lesser[Key]) (*OnMap[Key, Element], error) {
+	if lesser == nil {
+		return nil, ErrNilLesser
 	}
-	om.Close()
+	return &OnMap[Key, Element]{mp: m, ls: lesser}, nil
 }
 
-func reInitOnMap[Key comparable, Element any](om *OnMap[Key, Element]) {
-	om.closed = false
-	om.closedCh = make(chan struct{}, 1)
-	om.crrntCh = make(chan KeyElement[Key, Element])
-	go rangeOnMap(om)
+// NewOnMapSortedMust is like NewOnMapSorted but panics in case of error.
+func NewOnMapSortedMust[Key comparable, Element any](m map[Key]Element, lesser Lesser[Key]) *OnMap[Key, Element] {
+	r, err := NewOnMapSorted(m, lesser)
+	if err != nil {
+		panic(err)
+	}
+	return r
 }
 
-// NewOnMap creates a new OnMap based on the provided map.
-func NewOnMap[Key comparable, Element any](m map[Key]Element) *OnMap[Key, Element] {
-	onMap := OnMap[Key, Element]{mp: m}
-	reInitOnMap(&onMap)
-	return &onMap
+// snapshot takes a snapshot of the map's keys
+func (en *OnMap[Key, Element]) snapshot() {
+	en.kk = make([]Key, 0, len(en.mp))
+	for k := range en.mp {
+		en.kk = append(en.kk, k)
+	}
+	if en.ls != nil {
+		sort.Slice(en.kk, func(i, j int) bool { return en.ls.Less(en.kk[i], en.kk[j]) })
+	}
 }
 
 // MoveNext implements the Enumerator.MoveNext method.
@@ -57,12 +73,18 @@ func (en *OnMap[Key, Element]) MoveNext() bool {
 	if en.closed {
 		return false
 	}
-	var ok bool
-	en.crrnt, ok = <-en.crrntCh
-	if !ok {
-		return false
+	if en.kk == nil {
+		en.snapshot()
 	}
-	return true
+	for en.indx < len(en.kk) {
+		k := en.kk[en.indx]
+		en.indx++
+		if e, ok := en.mp[k]; ok {
+			en.crrnt = KeyElement[Key, Element]{key: k, element: e}
+			return true
+		}
+	}
+	return false
 }
 
 // Current implements the Enumerator.Current method.
@@ -71,22 +93,39 @@ func (en *OnMap[Key, Element]) Current() KeyElement[Key, Element] {
 }
 
 // Reset implements the Enumerator.Reset method.
+//
+// Reset discards the snapshot of the map's keys,
+// so the next enumeration reflects the map's changes made since the previous snapshot.
+// Reset also reopens the closed OnMap.
 func (en *OnMap[Key, Element]) Reset() {
-	if !en.closed {
-		en.Close()
+	en.kk = nil
+	en.indx = 0
+	en.closed = false
+	en.crrnt = KeyElement[Key, Element]{}
+}
+
+// Count implements the Counter interface.
+//
+// Count returns the number of elements the whole enumeration yields:
+// once the snapshot of the map's keys has been taken, the snapshot's keys still present in the map are counted,
+// before that the map's keys are counted.
+func (en *OnMap[Key, Element]) Count() int {
+	if en.kk == nil {
+		return len(en.mp)
+	}
+	r := 0
+	for _, k := range en.kk {
+		if _, ok := en.mp[k]; ok {
+			r++
+		}
 	}
-	reInitOnMap(en)
+	return r
 }
 
 // Close implements the io.Closer interface.
+// Close releases the snapshot of the map's keys. Subsequent calls to MoveNext return false until Reset is called.
 func (en *OnMap[Key, Element]) Close() error {
-	en.closeMtx.Lock()
-	defer en.closeMtx.Unlock()
-	if en.closed {
-		return nil
-	}
 	en.closed = true
-	en.closedCh <- struct{}{}
-	close(en.crrntCh)
+	en.kk = nil
 	return nil
 }
@@ -3,10 +3,11 @@
 package go2linq
 
 import (
+	"reflect"
 	"testing"
 )
 
-func Test_NewOnMap_int_string(t *testing.T) {
+func Test_NewOnMapSorted_int_string(t *testing.T) {
 	m1 := make(map[int]string)
 	m1[1] = "one"
 	m1[2] = "two"
@@ -31,12 +32,112 @@ func Test_NewOnMap_int_string(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := NewOnMap[int, string](tt.args.m)
+			got := NewOnMapSortedMust[int, string](tt.args.m, Order[int]{})
 			if !SequenceEqualMust[KeyElement[int, string]](got, tt.want) {
 				got.Reset()
 				tt.want.Reset()
-				t.Errorf("NewOnMap() = '%v', want '%v'", String[KeyElement[int, string]](got), String(tt.want))
+				t.Errorf("NewOnMapSorted() = '%v', want '%v'", String[KeyElement[int, string]](got), String(tt.want))
 			}
 		})
 	}
 }
+
+func Test_NewOnMapSorted_nil_lesser(t *testing.T) {
+	_, err := NewOnMapSorted[int, string](map[int]string{1: "one"}, nil)
+	if err != ErrNilLesser {
+		t.Errorf("NewOnMapSorted() error = '%v', want '%v'", err, ErrNilLesser)
+	}
+}
+
+func Test_NewOnMapSorted_string_int_desc(t *testing.T) {
+	m := map[string]int{"one": 1, "two": 2, "three": 3, "four": 4}
+	got := SelectMust[KeyElement[string, int], string](
+		NewOnMapSortedMust[string, int](m, LesserFunc[string](func(s1, s2 string) bool { return s1 > s2 })),
+		func(ke KeyElement[string, int]) string { return ke.key },
+	)
+	want := NewOnSlice("two", "three", "one", "four")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("NewOnMapSorted() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_OnMap_Count_Reset(t *testing.T) {
+	m := map[int]string{1: "one", 2: "two", 3: "three"}
+	om := NewOnMapSortedMust[int, string](m, Order[int]{})
+	if got := om.Count(); got != 3 {
+		t.Errorf("OnMap.Count() = %v, want %v", got, 3)
+	}
+	if got := len(Slice[KeyElement[int, string]](om)); got != 3 {
+		t.Errorf("len(Slice(OnMap)) = %v, want %v", got, 3)
+	}
+	om.Reset()
+	if got := len(Slice[KeyElement[int, string]](om)); got != 3 {
+		t.Errorf("len(Slice(OnMap)) after Reset = %v, want %v", got, 3)
+	}
+}
+
+func Test_OnMap_Count_deleted(t *testing.T) {
+	m := map[int]string{1: "one", 2: "two", 3: "three"}
+	om := NewOnMapSortedMust[int, string](m, Order[int]{})
+	if !om.MoveNext() || om.Current().key != 1 {
+		t.Fatalf("OnMap.MoveNext() failed")
+	}
+	// Count agrees with the enumeration, the deleted keys are skipped
+	delete(m, 2)
+	delete(m, 3)
+	if got := om.Count(); got != 1 {
+		t.Errorf("OnMap.Count() = %v, want %v", got, 1)
+	}
+	if got := len(Slice[KeyElement[int, string]](om)); got != 0 {
+		t.Errorf("len(Slice(OnMap)) after deletion = %v, want %v", got, 0)
+	}
+}
+
+func Test_OnMap_mutation(t *testing.T) {
+	m := map[int]string{1: "one", 2: "two", 3: "three"}
+	om := NewOnMapSortedMust[int, string](m, Order[int]{})
+	if !om.MoveNext() || om.Current().key != 1 {
+		t.Fatalf("OnMap.MoveNext() failed")
+	}
+	// removed key is skipped, added key is not enumerated until Reset
+	delete(m, 2)
+	m[4] = "four"
+	m[3] = "THREE"
+	want := []KeyElement[int, string]{{key: 3, element: "THREE"}}
+	if got := Slice[KeyElement[int, string]](om); !reflect.DeepEqual(got, want) {
+		t.Errorf("OnMap after mutation = '%v', want '%v'", got, want)
+	}
+	// the snapshot's keys still present in the map are counted
+	if got := om.Count(); got != 2 {
+		t.Errorf("OnMap.Count() after mutation = %v, want %v", got, 2)
+	}
+	m[5] = "five"
+	if got := om.Count(); got != 2 {
+		t.Errorf("OnMap.Count() after addition = %v, want %v", got, 2)
+	}
+	delete(m, 5)
+	om.Reset()
+	if got := om.Count(); got != 3 {
+		t.Errorf("OnMap.Count() after Reset = %v, want %v", got, 3)
+	}
+	want = []KeyElement[int, string]{{key: 1, element: "one"}, {key: 3, element: "THREE"}, {key: 4, element: "four"}}
+	if got := Slice[KeyElement[int, string]](om); !reflect.DeepEqual(got, want) {
+		t.Errorf("OnMap after Reset = '%v', want '%v'", got, want)
+	}
+}
+
+func Test_OnMap_Close(t *testing.T) {
+	om := NewOnMap(map[int]string{1: "one", 2: "two", 3: "three"})
+	en := TakeMust[KeyElement[int, string]](om, 1)
+	for en.MoveNext() {
+	}
+	if om.MoveNext() {
+		t.Errorf("OnMap.MoveNext() after Close = true, want false")
+	}
+	om.Reset()
+	if got := len(Slice[KeyElement[int, string]](om)); got != 3 {
+		t.Errorf("len(Slice(OnMap)) after Reset = %v, want %v", got, 3)
+	}
+}
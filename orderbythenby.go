@@ -24,6 +24,7 @@ func OrderByLs[Source, Key any](source Enumerator[Source],
 	return &OrderedEnumerable[Source]{
 			source,
 			projectionLesser(lesser, keySelector),
+			nil,
 		},
 		nil
 }
@@ -78,6 +79,7 @@ func OrderByDescendingLs[Source, Key any](source Enumerator[Source],
 	return &OrderedEnumerable[Source]{
 			source,
 			reverseLesser(projectionLesser(lesser, keySelector)),
+			nil,
 		},
 		nil
 }
@@ -133,6 +135,7 @@ func ThenByLs[Source, Key any](source *OrderedEnumerable[Source],
 	return &OrderedEnumerable[Source]{
 			source.en,
 			compoundLesser(source.ls, projectionLesser(lesser, keySelector)),
+			source.ext,
 		},
 		nil
 }
@@ -188,6 +191,7 @@ func ThenByDescendingLs[Source, Key any](source *OrderedEnumerable[Source],
 	return &OrderedEnumerable[Source]{
 			source.en,
 			compoundLesser(source.ls, reverseLesser(projectionLesser(lesser, keySelector))),
+			source.ext,
 		},
 		nil
 }
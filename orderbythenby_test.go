@@ -4,6 +4,7 @@ package go2linq
 
 import (
 	"math"
+	"reflect"
 	"testing"
 )
 
@@ -545,3 +546,91 @@ func Test_ThenByDescendingLsMust_2(t *testing.T) {
 		})
 	}
 }
+
+func Test_OrderedEnumerable_partial(t *testing.T) {
+	type pair struct{ key, pos int }
+	// many equal keys to check the stability of the fast paths
+	var ee []pair
+	for i := 0; i < 1000; i++ {
+		ee = append(ee, pair{key: (i * 7919) % 37, pos: i})
+	}
+	oe := func() *OrderedEnumerable[pair] {
+		return OrderByLsMust(NewOnSliceEn(ee...), func(p pair) int { return p.key }, Lesser[int](Order[int]{}))
+	}
+	want := Slice(oe().GetEnumerator())
+	if got := FirstMust(oe().GetEnumerator()); got != want[0] {
+		t.Errorf("First(OrderByLs()) = %v, want %v", got, want[0])
+	}
+	if got := LastMust(oe().GetEnumerator()); got != want[len(want)-1] {
+		t.Errorf("Last(OrderByLs()) = %v, want %v", got, want[len(want)-1])
+	}
+	for _, i := range []int{0, 1, 27, 500, 998, 999} {
+		if got := ElementAtMust(oe().GetEnumerator(), i); got != want[i] {
+			t.Errorf("ElementAt(OrderByLs(), %d) = %v, want %v", i, got, want[i])
+		}
+	}
+	if _, err := ElementAt(oe().GetEnumerator(), 1000); err != ErrIndexOutOfRange {
+		t.Errorf("ElementAt(OrderByLs(), 1000) error = '%v', want '%v'", err, ErrIndexOutOfRange)
+	}
+	for _, k := range []int{1, 10, 999, 1000, 2000} {
+		got := Slice(TakeMust(oe().GetEnumerator(), k))
+		w := want
+		if k < len(w) {
+			w = w[:k]
+		}
+		if !reflect.DeepEqual(got, w) {
+			t.Errorf("Take(OrderByLs(), %d) = '%v', want '%v'", k, got, w)
+		}
+		top := TakeMust(oe().GetEnumerator(), k)
+		if got := LastMust(top); got != w[len(w)-1] {
+			t.Errorf("Last(Take(OrderByLs(), %d)) = %v, want %v", k, got, w[len(w)-1])
+		}
+	}
+	minMax := []struct {
+		name string
+		f    func(Enumerator[pair]) pair
+	}{
+		{name: "MinEl(key)", f: func(en Enumerator[pair]) pair {
+			return MinElMust(en, func(p pair) int { return p.key }, Lesser[int](Order[int]{}))
+		}},
+		{name: "MaxEl(key)", f: func(en Enumerator[pair]) pair {
+			return MaxElMust(en, func(p pair) int { return p.key }, Lesser[int](Order[int]{}))
+		}},
+		{name: "MinEl(pos%10)", f: func(en Enumerator[pair]) pair {
+			return MinElMust(en, func(p pair) int { return p.pos % 10 }, Lesser[int](Order[int]{}))
+		}},
+		{name: "MaxEl(pos%10)", f: func(en Enumerator[pair]) pair {
+			return MaxElMust(en, func(p pair) int { return p.pos % 10 }, Lesser[int](Order[int]{}))
+		}},
+		{name: "Min(pos)", f: func(en Enumerator[pair]) pair {
+			return pair{pos: MinMust(en, func(p pair) int { return p.pos }, Lesser[int](Order[int]{}))}
+		}},
+		{name: "Max(pos)", f: func(en Enumerator[pair]) pair {
+			return pair{pos: MaxMust(en, func(p pair) int { return p.pos }, Lesser[int](Order[int]{}))}
+		}},
+	}
+	for _, mm := range minMax {
+		if got, w := mm.f(oe().GetEnumerator()), mm.f(NewOnSliceEn(want...)); got != w {
+			t.Errorf("%s(OrderByLs()) = %v, want %v", mm.name, got, w)
+		}
+		if got, w := mm.f(TakeMust(oe().GetEnumerator(), 100)), mm.f(NewOnSliceEn(want[:100]...)); got != w {
+			t.Errorf("%s(Take(OrderByLs(), 100)) = %v, want %v", mm.name, got, w)
+		}
+	}
+	if _, err := Min(OrderByLsMust(Empty[pair](), func(p pair) int { return p.key }, Lesser[int](Order[int]{})).GetEnumerator(),
+		func(p pair) int { return p.key }, Lesser[int](Order[int]{})); err != ErrEmptySource {
+		t.Errorf("Min(OrderByLs(Empty())) error = '%v', want '%v'", err, ErrEmptySource)
+	}
+	if _, err := First(OrderByLsMust(Empty[pair](), func(p pair) int { return p.key }, Lesser[int](Order[int]{})).GetEnumerator()); err != ErrEmptySource {
+		t.Errorf("First(OrderByLs(Empty())) error = '%v', want '%v'", err, ErrEmptySource)
+	}
+}
+
+func Test_OrderedEnumerable_partial_started(t *testing.T) {
+	en := OrderByLsMust(NewOnSliceEn(3, 1, 2), Identity[int], Lesser[int](Order[int]{})).GetEnumerator()
+	en.MoveNext()
+	// the enumeration has been started, so First returns the next element
+	if got := FirstMust(en); got != 2 {
+		t.Errorf("First() = %v, want %v", got, 2)
+	}
+}
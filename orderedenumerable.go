@@ -3,8 +3,8 @@
 package go2linq
 
 import (
+	"container/heap"
 	"sort"
-	"sync"
 )
 
 // Reimplementing LINQ to Objects: Part 26a – IOrderedEnumerable
@@ -18,28 +18,286 @@ import (
 type OrderedEnumerable[Element any] struct {
 	en Enumerator[Element]
 	ls Lesser[Element]
+	// ext - if not nil, the sequence is sorted externally (see OrderByExternal)
+	ext *ExternalSortOptions[Element]
 }
 
 // GetEnumerator converts OrderedEnumerable to sorted sequence using sort.SliceStable for sorting.
+//
+// The sorting is deferred until the first call to MoveNext.
+// First, Last, ElementAt (and their …OrDefault counterparts) and Take applied to the not yet enumerated result
+// avoid the full sort (as .NET's OrderedPartition does):
+// First and Last find the minimum and the maximum element in a single pass,
+// Min, MinEl, Max and MaxEl scan the unsorted elements,
+// ElementAt uses selection (quickselect) and Take(…, k) uses a heap to select the k least elements
+// which are then sorted (O(n log k) instead of O(n log n)).
+//
+// If OrderedEnumerable has been created by OrderByExternal (or OrderByDescendingExternal),
+// the sequence is sorted externally (see OrderByExternal).
 func (oe *OrderedEnumerable[Element]) GetEnumerator() Enumerator[Element] {
-	var once sync.Once
-	var elel []Element
-	idx := 0
-	return OnFunc[Element]{
-		mvNxt: func() bool {
-			once.Do(func() {
-				elel = Slice(oe.en)
-				sort.SliceStable(elel, func(i, j int) bool {
-					return oe.ls.Less(elel[i], elel[j])
-				})
-			})
-			if idx >= len(elel) {
-				return false
+	if oe.ext != nil {
+		return &externalEnumerator[Element]{oe: oe}
+	}
+	return &orderedEnumerator[Element]{oe: oe, limit: -1}
+}
+
+// orderedPartition is implemented by the Enumerators able to compute some operators
+// without enumerating the whole sorted sequence.
+// The methods are applicable only if fresh returns true.
+type orderedPartition[T any] interface {
+	// fresh reports whether the enumeration has not been started yet
+	fresh() bool
+	first() (T, error)
+	last() (T, error)
+	elementAt(index int) (T, error)
+	take(count int) Enumerator[T]
+	// elements returns the elements to enumerate in arbitrary order (nil in case of error, see Err)
+	// and the function reporting whether the element with index 'i' precedes the element with index 'j'
+	// in the sorted sequence
+	elements() ([]T, func(i, j int) bool)
+}
+
+// orderedEnumerator is the Enumerator returned by OrderedEnumerable.GetEnumerator
+type orderedEnumerator[T any] struct {
+	oe *OrderedEnumerable[T]
+	// limit - if not negative, only the first 'limit' elements of the sorted sequence are enumerated
+	limit int
+	// elel - the source's elements, sorted if 'sorted' is true
+	elel   []T
+	loaded bool
+	sorted bool
+	idx    int
+}
+
+// load loads the source's elements
+func (en *orderedEnumerator[T]) load() error {
+	if !en.loaded {
+		en.elel = Slice(en.oe.en)
+		en.loaded = true
+	}
+	return Err(en.oe.en)
+}
+
+// stableLess compares the elements with indexes 'i' and 'j' in en.elel,
+// the equal elements are ordered by their positions in the source
+func (en *orderedEnumerator[T]) stableLess(i, j int) bool {
+	if en.oe.ls.Less(en.elel[i], en.elel[j]) {
+		return true
+	}
+	if en.oe.ls.Less(en.elel[j], en.elel[i]) {
+		return false
+	}
+	return i < j
+}
+
+// count returns the number of elements to enumerate
+func (en *orderedEnumerator[T]) count() int {
+	if 0 <= en.limit && en.limit < len(en.elel) {
+		return en.limit
+	}
+	return len(en.elel)
+}
+
+// sort sorts the first en.count() elements of en.elel
+func (en *orderedEnumerator[T]) sort() {
+	if en.sorted {
+		return
+	}
+	en.sorted = true
+	if en.count() < len(en.elel) {
+		en.elel = en.topK(en.count())
+		return
+	}
+	sort.SliceStable(en.elel, func(i, j int) bool {
+		return en.oe.ls.Less(en.elel[i], en.elel[j])
+	})
+}
+
+// indexHeap is a max-heap of indexes in orderedEnumerator.elel
+type indexHeap[T any] struct {
+	en *orderedEnumerator[T]
+	ii []int
+}
+
+func (h *indexHeap[T]) Len() int           { return len(h.ii) }
+func (h *indexHeap[T]) Less(i, j int) bool { return h.en.stableLess(h.ii[j], h.ii[i]) }
+func (h *indexHeap[T]) Swap(i, j int)      { h.ii[i], h.ii[j] = h.ii[j], h.ii[i] }
+func (h *indexHeap[T]) Push(x any)         { h.ii = append(h.ii, x.(int)) }
+func (h *indexHeap[T]) Pop() any {
+	x := h.ii[len(h.ii)-1]
+	h.ii = h.ii[:len(h.ii)-1]
+	return x
+}
+
+// topK returns the 'k' least elements of en.elel in sorted order
+func (en *orderedEnumerator[T]) topK(k int) []T {
+	h := &indexHeap[T]{en: en, ii: make([]int, 0, k)}
+	for i := range en.elel {
+		if h.Len() < k {
+			heap.Push(h, i)
+			continue
+		}
+		if en.stableLess(i, h.ii[0]) {
+			h.ii[0] = i
+			heap.Fix(h, 0)
+		}
+	}
+	sort.Slice(h.ii, func(i, j int) bool { return en.stableLess(h.ii[i], h.ii[j]) })
+	r := make([]T, len(h.ii))
+	for i, ix := range h.ii {
+		r[i] = en.elel[ix]
+	}
+	return r
+}
+
+// selectAt returns the element that is at position 'k' in the stably sorted en.elel (quickselect)
+func (en *orderedEnumerator[T]) selectAt(k int) T {
+	ii := make([]int, len(en.elel))
+	for i := range ii {
+		ii[i] = i
+	}
+	lo, hi := 0, len(ii)-1
+	for lo < hi {
+		// Lomuto partition around the middle element
+		mid := lo + (hi-lo)/2
+		ii[mid], ii[hi] = ii[hi], ii[mid]
+		p := lo
+		for i := lo; i < hi; i++ {
+			if en.stableLess(ii[i], ii[hi]) {
+				ii[i], ii[p] = ii[p], ii[i]
+				p++
 			}
-			idx++
-			return true
-		},
-		crrnt: func() Element { return elel[idx-1] },
-		rst:   func() { idx = 0 },
+		}
+		ii[p], ii[hi] = ii[hi], ii[p]
+		switch {
+		case k < p:
+			hi = p - 1
+		case k > p:
+			lo = p + 1
+		default:
+			return en.elel[ii[k]]
+		}
+	}
+	return en.elel[ii[k]]
+}
+
+// MoveNext implements the Enumerator.MoveNext method.
+func (en *orderedEnumerator[T]) MoveNext() bool {
+	if en.load() != nil {
+		return false
+	}
+	en.sort()
+	if en.idx >= en.count() {
+		return false
+	}
+	en.idx++
+	return true
+}
+
+// Current implements the Enumerator.Current method.
+func (en *orderedEnumerator[T]) Current() T {
+	if en.idx < 1 || !en.sorted {
+		return ZeroValue[T]()
+	}
+	return en.elel[en.idx-1]
+}
+
+// Reset implements the Enumerator.Reset method.
+func (en *orderedEnumerator[T]) Reset() {
+	en.idx = 0
+}
+
+// Err implements the ErrEnumerator.Err method.
+func (en *orderedEnumerator[T]) Err() error {
+	return Err(en.oe.en)
+}
+
+// Close implements the io.Closer interface.
+func (en *orderedEnumerator[T]) Close() error {
+	return Close(en.oe.en)
+}
+
+func (en *orderedEnumerator[T]) fresh() bool {
+	return en.idx == 0
+}
+
+func (en *orderedEnumerator[T]) first() (T, error) {
+	if err := en.load(); err != nil {
+		return ZeroValue[T](), err
+	}
+	if en.count() == 0 {
+		return ZeroValue[T](), ErrEmptySource
+	}
+	if en.sorted {
+		return en.elel[0], nil
+	}
+	m := 0
+	for i := 1; i < len(en.elel); i++ {
+		if en.stableLess(i, m) {
+			m = i
+		}
+	}
+	return en.elel[m], nil
+}
+
+func (en *orderedEnumerator[T]) last() (T, error) {
+	if err := en.load(); err != nil {
+		return ZeroValue[T](), err
+	}
+	c := en.count()
+	if c == 0 {
+		return ZeroValue[T](), ErrEmptySource
+	}
+	if en.sorted {
+		return en.elel[c-1], nil
+	}
+	if c < len(en.elel) {
+		return en.selectAt(c - 1), nil
+	}
+	m := 0
+	for i := 1; i < len(en.elel); i++ {
+		if en.stableLess(m, i) {
+			m = i
+		}
+	}
+	return en.elel[m], nil
+}
+
+func (en *orderedEnumerator[T]) elementAt(index int) (T, error) {
+	if err := en.load(); err != nil {
+		return ZeroValue[T](), err
+	}
+	if index < 0 || index >= en.count() {
+		return ZeroValue[T](), ErrIndexOutOfRange
+	}
+	if en.sorted {
+		return en.elel[index], nil
+	}
+	return en.selectAt(index), nil
+}
+
+func (en *orderedEnumerator[T]) take(count int) Enumerator[T] {
+	limit := count
+	if 0 <= en.limit && en.limit < limit {
+		limit = en.limit
+	}
+	r := &orderedEnumerator[T]{oe: en.oe, limit: limit}
+	if en.loaded {
+		// the source has already been consumed by 'en'
+		r.elel = append([]T(nil), en.elel...)
+		r.loaded, r.sorted = true, en.sorted
+	}
+	return r
+}
+
+func (en *orderedEnumerator[T]) elements() ([]T, func(i, j int) bool) {
+	if err := en.load(); err != nil {
+		return nil, nil
+	}
+	if en.count() < len(en.elel) {
+		// the elements enumerated are the least ones, so they are selected (and sorted)
+		en.sort()
+		return en.elel[:en.count()], en.stableLess
 	}
+	return en.elel, en.stableLess
 }
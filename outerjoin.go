@@ -0,0 +1,126 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"sync"
+)
+
+// https://docs.microsoft.com/dotnet/csharp/linq/perform-left-outer-joins
+// https://en.wikipedia.org/wiki/Join_(SQL)#Outer_join
+
+// outerJoin correlates the elements of two sequences based on matching keys.
+// Each outer element is paired with each matching inner element
+// or with nil if there are no matching inner elements.
+// If 'full' is true, the inner elements not matched by any outer element
+// are paired with nil after all the outer elements.
+// 'newLookup' creates the Lookup used to match the keys. 'inner' is enumerated on the first MoveNext.
+func outerJoin[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner],
+	outerKeySelector func(Outer) Key, innerKeySelector func(Inner) Key, resultSelector func(*Outer, *Inner) Result,
+	newLookup func() *Lookup[Key, int], full bool) Enumerator[Result] {
+	var once sync.Once
+	var isl []Inner
+	// ilk maps the inner keys to the positions of the inner elements in 'isl'
+	var ilk *Lookup[Key, int]
+	var ierr error
+	// matched[i] reports whether isl[i] has been matched by an outer element
+	var matched []bool
+	var oel *Outer
+	var iel *Inner
+	// ii - the positions of the inner elements matching the current outer element
+	var ii []int
+	var outerDone bool
+	// rest - the position of the next inner element to check for being unmatched
+	var rest int
+	return OnFunc[Result]{
+		mvNxt: func() bool {
+			once.Do(func() {
+				isl = Slice(inner)
+				ierr = Err(inner)
+				Close(inner)
+				ilk = newLookup()
+				for i, el := range isl {
+					ilk.add(innerKeySelector(el), i)
+				}
+				matched = make([]bool, len(isl))
+			})
+			if ierr != nil {
+				return false
+			}
+			for {
+				if len(ii) > 0 {
+					i := ii[0]
+					ii = ii[1:]
+					matched[i] = true
+					el := isl[i]
+					iel = &el
+					return true
+				}
+				if !outerDone {
+					if outer.MoveNext() {
+						o := outer.Current()
+						oel = &o
+						ii = ilk.ItemSlice(outerKeySelector(o))
+						if len(ii) == 0 {
+							iel = nil
+							return true
+						}
+						continue
+					}
+					outerDone = true
+					if !full || Err(outer) != nil {
+						return false
+					}
+				}
+				for rest < len(isl) {
+					i := rest
+					rest++
+					if !matched[i] {
+						el := isl[i]
+						oel, iel = nil, &el
+						return true
+					}
+				}
+				return false
+			}
+		},
+		crrnt: func() Result { return resultSelector(oel, iel) },
+		rst: func() {
+			outer.Reset()
+			ii = nil
+			outerDone = false
+			rest = 0
+			for i := range matched {
+				matched[i] = false
+			}
+		},
+		err: func() error { return firstErr(ierr, Err(outer)) },
+		cls: func() error { return firstErr(Close(outer), Close(inner)) },
+	}
+}
+
+// outerJoinSelf buffers 'inner', resets 'outer' and calls outerJoin
+// (so 'outer' and 'inner' may be based on the same Enumerator)
+func outerJoinSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner],
+	outerKeySelector func(Outer) Key, innerKeySelector func(Inner) Key, resultSelector func(*Outer, *Inner) Result,
+	newLookup func() *Lookup[Key, int], full bool) (Enumerator[Result], error) {
+	isl := Slice(inner)
+	if err := Err(inner); err != nil {
+		return nil, err
+	}
+	outer.Reset()
+	return outerJoin(outer, NewOnSliceEn(isl...), outerKeySelector, innerKeySelector, resultSelector, newLookup, full), nil
+}
+
+// lookupEqFactory returns a function that creates a Lookup using 'equaler' (reflect.DeepEqual if 'equaler' is nil)
+func lookupEqFactory[Key any](equaler Equaler[Key]) func() *Lookup[Key, int] {
+	if equaler == nil {
+		equaler = EqualerFunc[Key](DeepEqual[Key])
+	}
+	return func() *Lookup[Key, int] { return newLookupEq[Key, int](equaler) }
+}
+
+// lookupCmpFactory returns a function that creates a Lookup using 'comparer'
+func lookupCmpFactory[Key any](comparer Comparer[Key]) func() *Lookup[Key, int] {
+	return func() *Lookup[Key, int] { return newLookupCmp[Key, int](comparer) }
+}
@@ -0,0 +1,163 @@
+//go:build go1.18
+
+package go2linq
+
+// https://docs.microsoft.com/dotnet/api/system.collections.generic.iset-1
+
+// Overlaps determines whether two sequences have at least one common element using reflect.DeepEqual to compare elements.
+// The enumeration of 'first' stops on the first common element.
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use OverlapsSelf instead.
+func Overlaps[Source any](first, second Enumerator[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	r, err := relateSets(first, second, lookupEqFactory[Source](nil), true)
+	if err != nil {
+		return false, err
+	}
+	return r.common, nil
+}
+
+// OverlapsMust is like Overlaps but panics in case of error.
+func OverlapsMust[Source any](first, second Enumerator[Source]) bool {
+	r, err := Overlaps(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// OverlapsSelf determines whether two sequences have at least one common element using reflect.DeepEqual to compare elements.
+// (See Overlaps function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func OverlapsSelf[Source any](first, second Enumerator[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return Overlaps(first, NewOnSliceEn(sl2...))
+}
+
+// OverlapsSelfMust is like OverlapsSelf but panics in case of error.
+func OverlapsSelfMust[Source any](first, second Enumerator[Source]) bool {
+	r, err := OverlapsSelf(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// OverlapsEq determines whether two sequences have at least one common element using a specified Equaler to compare elements.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See Overlaps function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use OverlapsEqSelf instead.
+func OverlapsEq[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	r, err := relateSets(first, second, lookupEqFactory(equaler), true)
+	if err != nil {
+		return false, err
+	}
+	return r.common, nil
+}
+
+// OverlapsEqMust is like OverlapsEq but panics in case of error.
+func OverlapsEqMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) bool {
+	r, err := OverlapsEq(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// OverlapsEqSelf determines whether two sequences have at least one common element using a specified Equaler to compare elements.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See Overlaps function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func OverlapsEqSelf[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return OverlapsEq(first, NewOnSliceEn(sl2...), equaler)
+}
+
+// OverlapsEqSelfMust is like OverlapsEqSelf but panics in case of error.
+func OverlapsEqSelfMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) bool {
+	r, err := OverlapsEqSelf(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// OverlapsCmp determines whether two sequences have at least one common element using a specified Comparer to compare elements.
+// (See Overlaps function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use OverlapsCmpSelf instead.
+func OverlapsCmp[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	if comparer == nil {
+		return false, ErrNilComparer
+	}
+	r, err := relateSets(first, second, lookupCmpFactory(comparer), true)
+	if err != nil {
+		return false, err
+	}
+	return r.common, nil
+}
+
+// OverlapsCmpMust is like OverlapsCmp but panics in case of error.
+func OverlapsCmpMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) bool {
+	r, err := OverlapsCmp(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// OverlapsCmpSelf determines whether two sequences have at least one common element using a specified Comparer to compare elements.
+// (See Overlaps function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func OverlapsCmpSelf[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	if comparer == nil {
+		return false, ErrNilComparer
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return OverlapsCmp(first, NewOnSliceEn(sl2...), comparer)
+}
+
+// OverlapsCmpSelfMust is like OverlapsCmpSelf but panics in case of error.
+func OverlapsCmpSelfMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) bool {
+	r, err := OverlapsCmpSelf(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
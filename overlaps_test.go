@@ -0,0 +1,35 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_OverlapsMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  Enumerator[int]
+		second Enumerator[int]
+		want   bool
+	}{
+		{name: "EmptyFirst", first: Empty[int](), second: NewOnSlice(1), want: false},
+		{name: "Common", first: NewOnSlice(5, 2, 7), second: NewOnSlice(1, 2), want: true},
+		{name: "Disjoint", first: NewOnSlice(3, 4), second: NewOnSlice(1, 2), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OverlapsMust(tt.first, tt.second); got != tt.want {
+				t.Errorf("Overlaps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Overlaps_stops(t *testing.T) {
+	// the enumeration of 'first' stops on the first common element, so the failing element is not reached
+	got, err := Overlaps(failAt(NewOnSliceEn(1, 2, 3), 3), NewOnSliceEn(2))
+	if err != nil || !got {
+		t.Errorf("Overlaps() = %v, %v, want true, nil", got, err)
+	}
+}
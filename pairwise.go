@@ -0,0 +1,52 @@
+//go:build go1.18
+
+package go2linq
+
+// https://morelinq.github.io/3.3/ref/api/html/M_MoreLinq_MoreEnumerable_Pairwise__2.htm
+
+// Pairwise applies 'selector' to each pair of adjacent elements of the sequence
+// (the previous element is the first argument).
+// The sequence of n elements produces n-1 results.
+func Pairwise[Source, Result any](source Enumerator[Source], selector func(Source, Source) Result) (Enumerator[Result], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if selector == nil {
+		return nil, ErrNilSelector
+	}
+	var prev, cur Source
+	started := false
+	return OnFunc[Result]{
+			mvNxt: func() bool {
+				if !started {
+					if !source.MoveNext() {
+						return false
+					}
+					cur = source.Current()
+					started = true
+				}
+				if !source.MoveNext() {
+					return false
+				}
+				prev, cur = cur, source.Current()
+				return true
+			},
+			crrnt: func() Result { return selector(prev, cur) },
+			rst: func() {
+				started = false
+				source.Reset()
+			},
+			err: func() error { return Err(source) },
+			cls: func() error { return Close(source) },
+		},
+		nil
+}
+
+// PairwiseMust is like Pairwise but panics in case of error.
+func PairwiseMust[Source, Result any](source Enumerator[Source], selector func(Source, Source) Result) Enumerator[Result] {
+	r, err := Pairwise(source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
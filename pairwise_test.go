@@ -0,0 +1,37 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_PairwiseMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		source Enumerator[int]
+		want   Enumerator[int]
+	}{
+		{name: "EmptySource", source: Empty[int](), want: Empty[int]()},
+		{name: "SingleElement", source: NewOnSlice(1), want: Empty[int]()},
+		{name: "Differences", source: NewOnSlice(1, 4, 9, 16), want: NewOnSlice(3, 5, 7)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PairwiseMust(tt.source, func(prev, cur int) int { return cur - prev })
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("Pairwise() = '%v', want '%v'", String(got), String(tt.want))
+			}
+			got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(got, tt.want) {
+				t.Errorf("Pairwise() after Reset differs")
+			}
+		})
+	}
+	if _, err := Pairwise[int, int](NewOnSliceEn(1), nil); err != ErrNilSelector {
+		t.Errorf("Pairwise() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+}
@@ -0,0 +1,435 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"constraints"
+	"context"
+	"io"
+	"runtime"
+	"sync"
+)
+
+// https://docs.microsoft.com/dotnet/standard/parallel-programming/introduction-to-plinq
+// https://docs.microsoft.com/dotnet/api/system.linq.parallelenumerable
+
+// ParallelOptions configures the parallel execution of a ParallelQuery.
+type ParallelOptions struct {
+	// Context may be used to cancel the query. context.Background() is used if Context is nil.
+	Context context.Context
+	// DegreeOfParallelism is the maximum number of concurrently executing tasks.
+	// runtime.GOMAXPROCS(0) is used if DegreeOfParallelism is not positive.
+	DegreeOfParallelism int
+	// Ordered determines whether the order of the source sequence is preserved (see ParallelQuery.AsOrdered).
+	Ordered bool
+}
+
+// ParallelQuery represents a sequence whose operators (ParallelSelect, ParallelWhere, etc.)
+// execute in parallel.
+//
+// The source sequence is enumerated sequentially by a single goroutine,
+// only the user functions (selectors, predicates, accumulators) are called concurrently,
+// so they must be safe for concurrent use.
+type ParallelQuery[T any] struct {
+	en   Enumerator[T]
+	opts ParallelOptions
+}
+
+// AsParallel enables parallelization of a query.
+func AsParallel[T any](source Enumerator[T], opts ParallelOptions) (*ParallelQuery[T], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if opts.Context == nil {
+		opts.Context = context.Background()
+	}
+	if opts.DegreeOfParallelism <= 0 {
+		opts.DegreeOfParallelism = runtime.GOMAXPROCS(0)
+	}
+	return &ParallelQuery[T]{en: source, opts: opts}, nil
+}
+
+// AsParallelMust is like AsParallel but panics in case of error.
+func AsParallelMust[T any](source Enumerator[T], opts ParallelOptions) *ParallelQuery[T] {
+	r, err := AsParallel(source, opts)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// AsOrdered returns a ParallelQuery whose subsequent operators preserve the order of the source sequence.
+func (pq *ParallelQuery[T]) AsOrdered() *ParallelQuery[T] {
+	opts := pq.opts
+	opts.Ordered = true
+	return &ParallelQuery[T]{en: pq.en, opts: opts}
+}
+
+// AsUnordered returns a ParallelQuery whose subsequent operators yield the elements as soon as they are ready.
+func (pq *ParallelQuery[T]) AsUnordered() *ParallelQuery[T] {
+	opts := pq.opts
+	opts.Ordered = false
+	return &ParallelQuery[T]{en: pq.en, opts: opts}
+}
+
+// AsSequential converts the ParallelQuery to an Enumerator.
+//
+// The resulting Enumerator implements ErrEnumerator (cancellation and the errors
+// encountered during the parallel execution are reported by its Err method) and io.Closer.
+// Close the resulting Enumerator if it is abandoned before the end,
+// so the goroutines executing the query are stopped.
+func (pq *ParallelQuery[T]) AsSequential() Enumerator[T] {
+	if _, ok := pq.en.(ErrEnumerator[T]); ok {
+		if _, ok := pq.en.(io.Closer); ok {
+			return pq.en
+		}
+	}
+	// the source of the ParallelQuery without parallel operators may not implement ErrEnumerator or io.Closer
+	return OnFunc[T]{
+		mvNxt: pq.en.MoveNext,
+		crrnt: pq.en.Current,
+		rst:   pq.en.Reset,
+		err:   func() error { return Err(pq.en) },
+		cls:   func() error { return Close(pq.en) },
+	}
+}
+
+// parallelResult is the outcome of a task applied to a single element of the source sequence
+type parallelResult[R any] struct {
+	rr  []R
+	err error
+}
+
+// parallelJob is a single element of the source sequence scheduled for the execution
+type parallelJob[S, R any] struct {
+	el  S
+	fut chan parallelResult[R]
+}
+
+// parallelMap applies 'f' to the elements of 'pq' using pq.opts.DegreeOfParallelism goroutines
+func parallelMap[S, R any](pq *ParallelQuery[S], f func(S) ([]R, error)) *ParallelQuery[R] {
+	var (
+		ctx     context.Context
+		cancel  context.CancelFunc
+		all     sync.WaitGroup
+		out     chan parallelResult[R]
+		futures chan chan parallelResult[R]
+		srcErr  error
+		started bool
+		done    bool
+		buf     []R
+		crrnt   R
+		err     error
+	)
+	start := func() {
+		started = true
+		ctx, cancel = context.WithCancel(pq.opts.Context)
+		// the goroutines use local copies, since Reset replaces the outer variables
+		ctx := ctx
+		jobs := make(chan parallelJob[S, R])
+		var o chan parallelResult[R]
+		var futs chan chan parallelResult[R]
+		if pq.opts.Ordered {
+			futs = make(chan chan parallelResult[R], pq.opts.DegreeOfParallelism)
+		} else {
+			o = make(chan parallelResult[R], pq.opts.DegreeOfParallelism)
+		}
+		out, futures = o, futs
+		// feeder
+		all.Add(1)
+		go func() {
+			defer all.Done()
+			defer func() {
+				close(jobs)
+				if futs != nil {
+					close(futs)
+				}
+			}()
+			for pq.en.MoveNext() {
+				j := parallelJob[S, R]{el: pq.en.Current()}
+				if futs != nil {
+					j.fut = make(chan parallelResult[R], 1)
+					select {
+					case <-ctx.Done():
+						return
+					case futs <- j.fut:
+					}
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case jobs <- j:
+				}
+			}
+			srcErr = Err(pq.en)
+		}()
+		// workers
+		var workers sync.WaitGroup
+		for i := 0; i < pq.opts.DegreeOfParallelism; i++ {
+			all.Add(1)
+			workers.Add(1)
+			go func() {
+				defer all.Done()
+				defer workers.Done()
+				for j := range jobs {
+					var r parallelResult[R]
+					if r.err = ctx.Err(); r.err == nil {
+						r.rr, r.err = f(j.el)
+					}
+					if j.fut != nil {
+						j.fut <- r
+						continue
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case o <- r:
+					}
+				}
+			}()
+		}
+		if o != nil {
+			go func() {
+				workers.Wait()
+				close(o)
+			}()
+		}
+	}
+	finish := func() {
+		done = true
+		if cancel != nil {
+			cancel()
+			all.Wait()
+		}
+	}
+	en := OnFunc[R]{
+		mvNxt: func() bool {
+			for {
+				if len(buf) > 0 {
+					crrnt = buf[0]
+					buf = buf[1:]
+					return true
+				}
+				if done {
+					return false
+				}
+				if !started {
+					start()
+				}
+				var r parallelResult[R]
+				var ok bool
+				if futures != nil {
+					var fut chan parallelResult[R]
+					if fut, ok = <-futures; ok {
+						select {
+						case <-ctx.Done():
+							r.err = ctx.Err()
+						case r = <-fut:
+						}
+					}
+				} else {
+					r, ok = <-out
+				}
+				if !ok {
+					err = firstErr(ctx.Err(), srcErr)
+					finish()
+					return false
+				}
+				if r.err != nil {
+					err = r.err
+					finish()
+					return false
+				}
+				buf = r.rr
+			}
+		},
+		crrnt: func() R { return crrnt },
+		rst: func() {
+			finish()
+			pq.en.Reset()
+			started, done = false, false
+			ctx, cancel, out, futures = nil, nil, nil, nil
+			srcErr, err = nil, nil
+			buf = nil
+		},
+		err: func() error { return err },
+		cls: func() error {
+			finish()
+			return Close(pq.en)
+		},
+	}
+	return &ParallelQuery[R]{en: en, opts: pq.opts}
+}
+
+// ParallelSelect projects in parallel each element of a sequence into a new form.
+func ParallelSelect[Source, Result any](source *ParallelQuery[Source], selector func(Source) Result) (*ParallelQuery[Result], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if selector == nil {
+		return nil, ErrNilSelector
+	}
+	return parallelMap(source, func(s Source) ([]Result, error) {
+		return []Result{selector(s)}, nil
+	}), nil
+}
+
+// ParallelSelectMust is like ParallelSelect but panics in case of error.
+func ParallelSelectMust[Source, Result any](source *ParallelQuery[Source], selector func(Source) Result) *ParallelQuery[Result] {
+	r, err := ParallelSelect(source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ParallelWhere filters in parallel a sequence of values based on a predicate.
+func ParallelWhere[Source any](source *ParallelQuery[Source], predicate func(Source) bool) (*ParallelQuery[Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if predicate == nil {
+		return nil, ErrNilPredicate
+	}
+	return parallelMap(source, func(s Source) ([]Source, error) {
+		if predicate(s) {
+			return []Source{s}, nil
+		}
+		return nil, nil
+	}), nil
+}
+
+// ParallelWhereMust is like ParallelWhere but panics in case of error.
+func ParallelWhereMust[Source any](source *ParallelQuery[Source], predicate func(Source) bool) *ParallelQuery[Source] {
+	r, err := ParallelWhere(source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ParallelSelectMany projects in parallel each element of a sequence to an Enumerator
+// and flattens the resulting sequences into one sequence.
+// Each resulting sequence is enumerated entirely by the goroutine that has called 'selector'.
+func ParallelSelectMany[Source, Result any](source *ParallelQuery[Source],
+	selector func(Source) Enumerator[Result]) (*ParallelQuery[Result], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if selector == nil {
+		return nil, ErrNilSelector
+	}
+	return parallelMap(source, func(s Source) ([]Result, error) {
+		return SliceErr(selector(s))
+	}), nil
+}
+
+// ParallelSelectManyMust is like ParallelSelectMany but panics in case of error.
+func ParallelSelectManyMust[Source, Result any](source *ParallelQuery[Source],
+	selector func(Source) Enumerator[Result]) *ParallelQuery[Result] {
+	r, err := ParallelSelectMany(source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ParallelAggregateSeed applies in parallel an accumulator function over a sequence.
+//
+// Each goroutine accumulates its own part of the sequence starting from the value returned by 'seedFactory'
+// (the zero value of Accumulate is used if 'seedFactory' is nil),
+// then the partial results are combined with 'combiner'.
+// So 'combiner' must be associative and commutative,
+// and the result does not depend on the order of the sequence (even if the ParallelQuery is ordered).
+func ParallelAggregateSeed[Source, Accumulate any](source *ParallelQuery[Source], seedFactory func() Accumulate,
+	accumulator func(Accumulate, Source) Accumulate, combiner func(Accumulate, Accumulate) Accumulate) (Accumulate, error) {
+	if source == nil {
+		return ZeroValue[Accumulate](), ErrNilSource
+	}
+	if accumulator == nil {
+		return ZeroValue[Accumulate](), ErrNilAccumulator
+	}
+	if combiner == nil {
+		return ZeroValue[Accumulate](), ErrNilCombiner
+	}
+	seed := func() Accumulate {
+		if seedFactory == nil {
+			return ZeroValue[Accumulate]()
+		}
+		return seedFactory()
+	}
+	ctx, cancel := context.WithCancel(source.opts.Context)
+	defer cancel()
+	jobs := make(chan Source)
+	var srcErr error
+	go func() {
+		defer close(jobs)
+		for source.en.MoveNext() {
+			select {
+			case <-ctx.Done():
+				Close(source.en)
+				return
+			case jobs <- source.en.Current():
+			}
+		}
+		srcErr = Err(source.en)
+	}()
+	partials := make([]Accumulate, source.opts.DegreeOfParallelism)
+	var wg sync.WaitGroup
+	for i := range partials {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			acc := seed()
+			for el := range jobs {
+				acc = accumulator(acc, el)
+			}
+			partials[i] = acc
+		}(i)
+	}
+	wg.Wait()
+	if err := firstErr(ctx.Err(), srcErr); err != nil {
+		return ZeroValue[Accumulate](), err
+	}
+	r := partials[0]
+	for _, p := range partials[1:] {
+		r = combiner(r, p)
+	}
+	return r, nil
+}
+
+// ParallelAggregateSeedMust is like ParallelAggregateSeed but panics in case of error.
+func ParallelAggregateSeedMust[Source, Accumulate any](source *ParallelQuery[Source], seedFactory func() Accumulate,
+	accumulator func(Accumulate, Source) Accumulate, combiner func(Accumulate, Accumulate) Accumulate) Accumulate {
+	r, err := ParallelAggregateSeed(source, seedFactory, accumulator, combiner)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ParallelSum computes in parallel the sum of a sequence of values that are obtained
+// by invoking a transform function on each element of the input sequence.
+func ParallelSum[Source any, Result constraints.Integer | constraints.Float](source *ParallelQuery[Source],
+	selector func(Source) Result) (Result, error) {
+	if source == nil {
+		return 0, ErrNilSource
+	}
+	if selector == nil {
+		return 0, ErrNilSelector
+	}
+	return ParallelAggregateSeed(source, nil,
+		func(acc Result, el Source) Result { return acc + selector(el) },
+		func(acc1, acc2 Result) Result { return acc1 + acc2 },
+	)
+}
+
+// ParallelSumMust is like ParallelSum but panics in case of error.
+func ParallelSumMust[Source any, Result constraints.Integer | constraints.Float](source *ParallelQuery[Source],
+	selector func(Source) Result) Result {
+	r, err := ParallelSum(source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
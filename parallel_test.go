@@ -0,0 +1,165 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelSelect_int(t *testing.T) {
+	square := func(i int) int { return i * i }
+	tests := []struct {
+		name string
+		opts ParallelOptions
+	}{
+		{name: "Ordered", opts: ParallelOptions{DegreeOfParallelism: 4, Ordered: true}},
+		{name: "Unordered", opts: ParallelOptions{DegreeOfParallelism: 4}},
+		{name: "DefaultDegree", opts: ParallelOptions{Ordered: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SliceErr(ParallelSelectMust(AsParallelMust(RangeMust(0, 1000), tt.opts), square).AsSequential())
+			if err != nil {
+				t.Fatalf("ParallelSelect() error = '%v'", err)
+			}
+			want := Slice(SelectMust(RangeMust(0, 1000), square))
+			if !tt.opts.Ordered {
+				sort.Ints(got)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ParallelSelect() = '%v', want '%v'", got, want)
+			}
+		})
+	}
+}
+
+func TestParallelSelect_DegreeOfParallelism(t *testing.T) {
+	var running, maxRunning int32
+	selector := func(i int) int {
+		r := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if r <= m || atomic.CompareAndSwapInt32(&maxRunning, m, r) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return i
+	}
+	pq := AsParallelMust(RangeMust(0, 100), ParallelOptions{DegreeOfParallelism: 3})
+	if got := len(Slice(ParallelSelectMust(pq, selector).AsSequential())); got != 100 {
+		t.Errorf("len(ParallelSelect()) = %v, want %v", got, 100)
+	}
+	if maxRunning > 3 {
+		t.Errorf("ParallelSelect() ran %v selectors concurrently, want at most %v", maxRunning, 3)
+	}
+}
+
+func TestParallelWhere_ParallelSelectMany(t *testing.T) {
+	pq := AsParallelMust(RangeMust(1, 6), ParallelOptions{DegreeOfParallelism: 2}).AsOrdered()
+	odd := ParallelWhereMust(pq, func(i int) bool { return i%2 == 1 })
+	rep := ParallelSelectManyMust(odd, func(i int) Enumerator[int] { return RepeatMust(i, i) })
+	got := Slice(rep.AsSequential())
+	want := []int{1, 3, 3, 3, 5, 5, 5, 5, 5}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParallelSelectMany(ParallelWhere()) = '%v', want '%v'", got, want)
+	}
+}
+
+func TestParallelSelect_errors(t *testing.T) {
+	pq := AsParallelMust[int](failAt(RangeMust(1, 100), 50), ParallelOptions{DegreeOfParallelism: 4, Ordered: true})
+	got, err := SliceErr(ParallelSelectMust(pq, Identity[int]).AsSequential())
+	if err != errTest {
+		t.Errorf("ParallelSelect() error = '%v', want '%v'", err, errTest)
+	}
+	if len(got) != 0 {
+		t.Errorf("ParallelSelect() = '%v', want empty", got)
+	}
+	if _, err := ParallelSelect[int, int](pq, nil); err != ErrNilSelector {
+		t.Errorf("ParallelSelect() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+}
+
+func TestParallelSelect_cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	pq := AsParallelMust(RangeMust(0, 1000), ParallelOptions{Context: ctx, DegreeOfParallelism: 2})
+	en := ParallelSelectMust(pq, func(i int) int {
+		if i == 10 {
+			cancel()
+		}
+		return i
+	}).AsSequential()
+	for en.MoveNext() {
+	}
+	if err := Err(en); err != context.Canceled {
+		t.Errorf("Err(ParallelSelect()) = '%v', want '%v'", err, context.Canceled)
+	}
+}
+
+func TestParallelSelect_Close(t *testing.T) {
+	cc := newCloseCounter(Slice(RangeMust(0, 1000))...)
+	en := ParallelSelectMust(AsParallelMust[int](cc, ParallelOptions{DegreeOfParallelism: 4}), Identity[int]).AsSequential()
+	if !en.MoveNext() {
+		t.Fatalf("ParallelSelect().MoveNext() = false, want true")
+	}
+	Close(en)
+	if cc.closed == 0 {
+		t.Errorf("Close() was not called on the source")
+	}
+	en.Reset()
+	if got := len(Slice(en)); got != 1000 {
+		t.Errorf("len(ParallelSelect()) after Reset = %v, want %v", got, 1000)
+	}
+}
+
+func TestParallelSum_ParallelAggregateSeed(t *testing.T) {
+	pq := AsParallelMust(RangeMust(1, 1000), ParallelOptions{DegreeOfParallelism: 8})
+	if got := ParallelSumMust(pq, Identity[int]); got != 500500 {
+		t.Errorf("ParallelSum() = %v, want %v", got, 500500)
+	}
+	pq.AsSequential().Reset()
+	got := ParallelAggregateSeedMust(pq,
+		func() map[int]int { return make(map[int]int) },
+		func(m map[int]int, i int) map[int]int { m[i%3]++; return m },
+		func(m1, m2 map[int]int) map[int]int {
+			for k, v := range m2 {
+				m1[k] += v
+			}
+			return m1
+		},
+	)
+	if want := map[int]int{0: 333, 1: 334, 2: 333}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParallelAggregateSeed() = '%v', want '%v'", got, want)
+	}
+	if _, err := ParallelAggregateSeed[int, int](pq, nil, func(a, i int) int { return a + i }, nil); err != ErrNilCombiner {
+		t.Errorf("ParallelAggregateSeed() error = '%v', want '%v'", err, ErrNilCombiner)
+	}
+}
+
+// plainEnumerator implements only the Enumerator interface
+type plainEnumerator[T any] struct{ Enumerator[T] }
+
+func TestAsSequential_interfaces(t *testing.T) {
+	ens := map[string]Enumerator[int]{
+		"Source":   AsParallelMust[int](plainEnumerator[int]{RangeMust(0, 3)}, ParallelOptions{}).AsSequential(),
+		"Parallel": ParallelSelectMust(AsParallelMust(RangeMust(0, 3), ParallelOptions{}), Identity[int]).AsSequential(),
+	}
+	for name, en := range ens {
+		if _, ok := en.(ErrEnumerator[int]); !ok {
+			t.Errorf("%s: AsSequential() does not implement ErrEnumerator", name)
+		}
+		if _, ok := en.(io.Closer); !ok {
+			t.Errorf("%s: AsSequential() does not implement io.Closer", name)
+		}
+		if got := Slice(en); len(got) != 3 {
+			t.Errorf("%s: len(Slice(AsSequential())) = %v, want %v", name, len(got), 3)
+		}
+	}
+}
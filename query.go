@@ -0,0 +1,287 @@
+//go:build go1.18
+
+package go2linq
+
+// Query is a chainable wrapper around Enumerator.
+//
+// Query's methods correspond to the operators that do not change the type of the elements.
+// Operators that change the type of the elements are available as Query… functions
+// (QuerySelect, QuerySelectMany, etc.), since Go methods cannot have type parameters.
+//
+// Query records the first error returned by an operator,
+// the following operators are not applied and the error is returned by the terminal methods
+// (Enumerator, ToSlice, Count, etc.).
+// Query is immutable: each method returns a new Query and leaves the receiver intact.
+type Query[T any] struct {
+	en Enumerator[T]
+	// oe - if not nil, en has been obtained from oe (see QueryOrderByLs and QueryThenByLs)
+	oe  *OrderedEnumerable[T]
+	err error
+}
+
+// NewQuery creates a new Query based on the provided Enumerator.
+func NewQuery[T any](source Enumerator[T]) *Query[T] {
+	if source == nil {
+		return &Query[T]{err: ErrNilSource}
+	}
+	return &Query[T]{en: source}
+}
+
+// NewQuerySlice creates a new Query based on the provided elements.
+func NewQuerySlice[T any](ee ...T) *Query[T] {
+	return NewQuery[T](NewOnSlice(ee...))
+}
+
+// then applies 'op' to q's Enumerator unless 'q' has already failed
+func (q *Query[T]) then(op func(Enumerator[T]) (Enumerator[T], error)) *Query[T] {
+	if q.err != nil {
+		return q
+	}
+	en, err := op(q.en)
+	if err != nil {
+		return &Query[T]{err: err}
+	}
+	return &Query[T]{en: en}
+}
+
+// queryThen applies 'op' to q's Enumerator unless 'q' has already failed
+func queryThen[Source, Result any](q *Query[Source],
+	op func(Enumerator[Source]) (Enumerator[Result], error)) *Query[Result] {
+	if q.err != nil {
+		return &Query[Result]{err: q.err}
+	}
+	en, err := op(q.en)
+	if err != nil {
+		return &Query[Result]{err: err}
+	}
+	return &Query[Result]{en: en}
+}
+
+// Where filters the Query's elements based on a predicate (see Where).
+func (q *Query[T]) Where(predicate func(T) bool) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return Where(en, predicate) })
+}
+
+// WhereIdx filters the Query's elements based on a predicate (see WhereIdx).
+func (q *Query[T]) WhereIdx(predicate func(T, int) bool) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return WhereIdx(en, predicate) })
+}
+
+// WhereErr filters the Query's elements based on a predicate that may fail (see WhereErr).
+func (q *Query[T]) WhereErr(predicate func(T) (bool, error)) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return WhereErr(en, predicate) })
+}
+
+// Skip bypasses a specified number of the Query's elements (see Skip).
+func (q *Query[T]) Skip(count int) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return Skip(en, count) })
+}
+
+// SkipLast omits the last 'count' elements of the Query (see SkipLast).
+func (q *Query[T]) SkipLast(count int) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return SkipLast(en, count) })
+}
+
+// SkipWhile bypasses the Query's elements as long as a condition is true (see SkipWhile).
+func (q *Query[T]) SkipWhile(predicate func(T) bool) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return SkipWhile(en, predicate) })
+}
+
+// Take returns a specified number of the Query's elements (see Take).
+func (q *Query[T]) Take(count int) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return Take(en, count) })
+}
+
+// TakeLast returns the last 'count' elements of the Query (see TakeLast).
+func (q *Query[T]) TakeLast(count int) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return TakeLast(en, count) })
+}
+
+// TakeWhile returns the Query's elements as long as a condition is true (see TakeWhile).
+func (q *Query[T]) TakeWhile(predicate func(T) bool) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return TakeWhile(en, predicate) })
+}
+
+// Distinct returns distinct elements of the Query (see Distinct).
+func (q *Query[T]) Distinct() *Query[T] {
+	return q.then(Distinct[T])
+}
+
+// DistinctEq returns distinct elements of the Query using a specified equaler (see DistinctEq).
+func (q *Query[T]) DistinctEq(equaler Equaler[T]) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return DistinctEq(en, equaler) })
+}
+
+// DistinctHash returns distinct elements of the Query using a specified hasher (see DistinctHash).
+func (q *Query[T]) DistinctHash(hasher Hasher[T]) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return DistinctHash(en, hasher) })
+}
+
+// Concat concatenates the Query with 'second' (see Concat).
+func (q *Query[T]) Concat(second Enumerator[T]) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return Concat(en, second) })
+}
+
+// Union produces the set union of the Query and 'second' (see Union).
+func (q *Query[T]) Union(second Enumerator[T]) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return Union(en, second) })
+}
+
+// Except produces the set difference of the Query and 'second' (see Except).
+func (q *Query[T]) Except(second Enumerator[T]) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return Except(en, second) })
+}
+
+// Intersect produces the set intersection of the Query and 'second' (see Intersect).
+func (q *Query[T]) Intersect(second Enumerator[T]) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return Intersect(en, second) })
+}
+
+// Append appends a value to the end of the Query (see Append).
+func (q *Query[T]) Append(element T) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return Append(en, element) })
+}
+
+// Prepend adds a value to the beginning of the Query (see Prepend).
+func (q *Query[T]) Prepend(element T) *Query[T] {
+	return q.then(func(en Enumerator[T]) (Enumerator[T], error) { return Prepend(en, element) })
+}
+
+// Reverse inverts the order of the Query's elements (see Reverse).
+func (q *Query[T]) Reverse() *Query[T] {
+	return q.then(Reverse[T])
+}
+
+// DefaultIfEmpty returns the Query's elements or a default valued singleton if the Query is empty (see DefaultIfEmpty).
+func (q *Query[T]) DefaultIfEmpty() *Query[T] {
+	return q.then(DefaultIfEmpty[T])
+}
+
+// OrderLs sorts the Query's elements in ascending order using a specified lesser (see OrderByLs).
+func (q *Query[T]) OrderLs(lesser Lesser[T]) *Query[T] {
+	return QueryOrderByLs(q, Identity[T], lesser)
+}
+
+// OrderDescendingLs sorts the Query's elements in descending order using a specified lesser
+// (see OrderByDescendingLs).
+func (q *Query[T]) OrderDescendingLs(lesser Lesser[T]) *Query[T] {
+	return QueryOrderByDescendingLs(q, Identity[T], lesser)
+}
+
+// Enumerator returns the Query's resulting Enumerator or the first error encountered while building the Query.
+func (q *Query[T]) Enumerator() (Enumerator[T], error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	return q.en, nil
+}
+
+// Err returns the first error encountered while building the Query.
+// Err does not enumerate the Query, so the errors encountered during iteration are not reported.
+func (q *Query[T]) Err() error {
+	return q.err
+}
+
+// ToSlice creates a slice from the Query.
+// ToSlice returns the first error encountered while building or enumerating the Query.
+func (q *Query[T]) ToSlice() ([]T, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	return SliceErr(q.en)
+}
+
+// Count returns the number of the Query's elements (see Count).
+func (q *Query[T]) Count() (int, error) {
+	if q.err != nil {
+		return -1, q.err
+	}
+	return Count(q.en)
+}
+
+// Any determines whether the Query contains any elements (see Any).
+func (q *Query[T]) Any() (bool, error) {
+	if q.err != nil {
+		return false, q.err
+	}
+	return Any(q.en)
+}
+
+// First returns the first element of the Query (see First).
+func (q *Query[T]) First() (T, error) {
+	if q.err != nil {
+		return ZeroValue[T](), q.err
+	}
+	return First(q.en)
+}
+
+// QuerySelect projects each element of the Query into a new form (see Select).
+func QuerySelect[Source, Result any](q *Query[Source], selector func(Source) Result) *Query[Result] {
+	return queryThen(q, func(en Enumerator[Source]) (Enumerator[Result], error) { return Select(en, selector) })
+}
+
+// QuerySelectErr projects each element of the Query into a new form using a selector that may fail
+// (see SelectErr).
+func QuerySelectErr[Source, Result any](q *Query[Source], selector func(Source) (Result, error)) *Query[Result] {
+	return queryThen(q, func(en Enumerator[Source]) (Enumerator[Result], error) { return SelectErr(en, selector) })
+}
+
+// QuerySelectMany projects each element of the Query to an Enumerator
+// and flattens the resulting sequences into one sequence (see SelectMany).
+func QuerySelectMany[Source, Result any](q *Query[Source], selector func(Source) Enumerator[Result]) *Query[Result] {
+	return queryThen(q, func(en Enumerator[Source]) (Enumerator[Result], error) { return SelectMany(en, selector) })
+}
+
+// queryOrdered applies 'op' producing OrderedEnumerable to q's Enumerator unless 'q' has already failed
+func queryOrdered[T any](q *Query[T], op func(Enumerator[T]) (*OrderedEnumerable[T], error)) *Query[T] {
+	if q.err != nil {
+		return q
+	}
+	oe, err := op(q.en)
+	if err != nil {
+		return &Query[T]{err: err}
+	}
+	return &Query[T]{en: oe.GetEnumerator(), oe: oe}
+}
+
+// QueryOrderByLs sorts the Query's elements in ascending order according to a key (see OrderByLs).
+// The resulting Query may be further sorted with QueryThenByLs or QueryThenByDescendingLs.
+func QueryOrderByLs[Source, Key any](q *Query[Source], keySelector func(Source) Key, lesser Lesser[Key]) *Query[Source] {
+	return queryOrdered(q, func(en Enumerator[Source]) (*OrderedEnumerable[Source], error) {
+		return OrderByLs(en, keySelector, lesser)
+	})
+}
+
+// QueryOrderByDescendingLs sorts the Query's elements in descending order according to a key
+// (see OrderByDescendingLs).
+// The resulting Query may be further sorted with QueryThenByLs or QueryThenByDescendingLs.
+func QueryOrderByDescendingLs[Source, Key any](q *Query[Source], keySelector func(Source) Key, lesser Lesser[Key]) *Query[Source] {
+	return queryOrdered(q, func(en Enumerator[Source]) (*OrderedEnumerable[Source], error) {
+		return OrderByDescendingLs(en, keySelector, lesser)
+	})
+}
+
+// QueryThenByLs performs a subsequent ordering of the Query's elements in ascending order (see ThenByLs).
+// 'q' must be the result of QueryOrderByLs, QueryOrderByDescendingLs, QueryThenByLs or QueryThenByDescendingLs,
+// otherwise ErrNotOrdered is recorded.
+func QueryThenByLs[Source, Key any](q *Query[Source], keySelector func(Source) Key, lesser Lesser[Key]) *Query[Source] {
+	return queryOrdered(q, func(Enumerator[Source]) (*OrderedEnumerable[Source], error) {
+		if q.oe == nil {
+			return nil, ErrNotOrdered
+		}
+		return ThenByLs(q.oe, keySelector, lesser)
+	})
+}
+
+// QueryThenByDescendingLs performs a subsequent ordering of the Query's elements in descending order
+// (see ThenByDescendingLs).
+// 'q' must be the result of QueryOrderByLs, QueryOrderByDescendingLs, QueryThenByLs or QueryThenByDescendingLs,
+// otherwise ErrNotOrdered is recorded.
+func QueryThenByDescendingLs[Source, Key any](q *Query[Source], keySelector func(Source) Key, lesser Lesser[Key]) *Query[Source] {
+	return queryOrdered(q, func(Enumerator[Source]) (*OrderedEnumerable[Source], error) {
+		if q.oe == nil {
+			return nil, ErrNotOrdered
+		}
+		return ThenByDescendingLs(q.oe, keySelector, lesser)
+	})
+}
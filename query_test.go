@@ -0,0 +1,130 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestQuery_int(t *testing.T) {
+	tests := []struct {
+		name    string
+		q       *Query[int]
+		want    []int
+		wantErr error
+	}{
+		{name: "NilSource",
+			q:       NewQuery[int](nil).Where(func(i int) bool { return true }),
+			wantErr: ErrNilSource,
+		},
+		{name: "Pipeline",
+			q: NewQuerySlice(5, 1, 4, 1, 2, 6, 3, 6).
+				Where(func(i int) bool { return i > 1 }).
+				Distinct().
+				OrderDescendingLs(Order[int]{}).
+				Skip(1).
+				Take(3),
+			want: []int{5, 4, 3},
+		},
+		{name: "ConcatAppendPrependReverse",
+			q: NewQuerySlice(1, 2).
+				Concat(NewOnSlice(3, 4)).
+				Append(5).
+				Prepend(0).
+				Reverse(),
+			want: []int{5, 4, 3, 2, 1, 0},
+		},
+		{name: "FirstErrorIsRecorded",
+			q: NewQuerySlice(1, 2, 3).
+				Where(nil).
+				OrderLs(nil).
+				Skip(1),
+			wantErr: ErrNilPredicate,
+		},
+		{name: "IterationError",
+			q: NewQuerySlice(1, 2, 3).
+				WhereErr(func(i int) (bool, error) {
+					if i == 2 {
+						return false, errTest
+					}
+					return true, nil
+				}),
+			wantErr: errTest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.q.ToSlice()
+			if err != tt.wantErr {
+				t.Errorf("Query.ToSlice() error = '%v', wantErr '%v'", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Query.ToSlice() = '%v', want '%v'", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuery_Immutable(t *testing.T) {
+	q := NewQuerySlice(1, 2, 3, 4)
+	q.Where(nil)
+	if err := q.Err(); err != nil {
+		t.Errorf("Query.Err() = '%v', want nil", err)
+	}
+	if got, _ := q.Count(); got != 4 {
+		t.Errorf("Query.Count() = %v, want %v", got, 4)
+	}
+	if got, err := q.Where(nil).Count(); got != -1 || err != ErrNilPredicate {
+		t.Errorf("Query.Where(nil).Count() = %v, '%v', want %v, '%v'", got, err, -1, ErrNilPredicate)
+	}
+}
+
+func TestQueryThenByLs(t *testing.T) {
+	type row struct {
+		name string
+		age  int
+	}
+	rows := []row{{"bob", 30}, {"amy", 25}, {"cat", 30}, {"dan", 25}, {"eve", 40}}
+	byAge := QueryOrderByDescendingLs(NewQuerySlice(rows...), func(r row) int { return r.age }, Lesser[int](Order[int]{}))
+	got, err := QueryThenByLs(byAge, func(r row) string { return r.name }, Lesser[string](Order[string]{})).ToSlice()
+	if err != nil {
+		t.Fatalf("QueryThenByLs().ToSlice() error = '%v'", err)
+	}
+	want := []row{{"eve", 40}, {"bob", 30}, {"cat", 30}, {"amy", 25}, {"dan", 25}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("QueryThenByLs() = '%v', want '%v'", got, want)
+	}
+	got, err = QueryThenByDescendingLs(byAge, func(r row) string { return r.name }, Lesser[string](Order[string]{})).ToSlice()
+	if err != nil {
+		t.Fatalf("QueryThenByDescendingLs().ToSlice() error = '%v'", err)
+	}
+	want = []row{{"eve", 40}, {"cat", 30}, {"bob", 30}, {"dan", 25}, {"amy", 25}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("QueryThenByDescendingLs() = '%v', want '%v'", got, want)
+	}
+	notOrdered := byAge.Where(func(row) bool { return true })
+	if _, err := QueryThenByLs(notOrdered, func(r row) string { return r.name }, Lesser[string](Order[string]{})).ToSlice(); err != ErrNotOrdered {
+		t.Errorf("QueryThenByLs().ToSlice() error = '%v', want '%v'", err, ErrNotOrdered)
+	}
+}
+
+func TestQuerySelect_string(t *testing.T) {
+	q := QueryOrderByLs(
+		QuerySelect(NewQuerySlice(3, 1, 2), func(i int) string { return fmt.Sprint(i * 10) }),
+		func(s string) string { return s },
+		Lesser[string](Order[string]{}),
+	)
+	got, err := q.ToSlice()
+	if err != nil {
+		t.Fatalf("Query.ToSlice() error = '%v'", err)
+	}
+	if want := []string{"10", "20", "30"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("QuerySelect() = '%v', want '%v'", got, want)
+	}
+	if _, err := QuerySelect[int, int](NewQuerySlice(1), nil).First(); err != ErrNilSelector {
+		t.Errorf("QuerySelect().First() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+}
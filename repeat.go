@@ -34,3 +34,12 @@ func RepeatMust[Result any](element Result, count int) Enumerator[Result] {
 	}
 	return r
 }
+
+// RepeatForever generates an infinite sequence that contains one repeated value
+// (use Take or TakeWhile to limit it).
+func RepeatForever[Result any](element Result) Enumerator[Result] {
+	return OnFunc[Result]{
+		mvNxt: func() bool { return true },
+		crrnt: func() Result { return element },
+	}
+}
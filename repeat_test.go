@@ -100,3 +100,13 @@ func Test_Repeat_int(t *testing.T) {
 		})
 	}
 }
+
+func Test_RepeatForever(t *testing.T) {
+	got := TakeMust(RepeatForever("x"), 3)
+	want := NewOnSliceEn("x", "x", "x")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("RepeatForever() = '%v', want '%v'", String(got), String(want))
+	}
+}
@@ -21,6 +21,9 @@ func Reverse[Source any](source Enumerator[Source]) (Enumerator[Source], error)
 	return OnFunc[Source]{
 			mvNxt: func() bool {
 				once.Do(func() { sl = Slice(source); i = len(sl) })
+				if Err(source) != nil {
+					return false
+				}
 				if i > 0 {
 					i--
 					return true
@@ -29,6 +32,8 @@ func Reverse[Source any](source Enumerator[Source]) (Enumerator[Source], error)
 			},
 			crrnt: func() Source { return sl[i] },
 			rst:   func() { i = len(sl) },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
 		},
 		nil
 }
@@ -0,0 +1,184 @@
+//go:build go1.18
+
+package go2linq
+
+// https://docs.microsoft.com/dotnet/api/system.linq.enumerable.rightjoin
+
+// RightJoin correlates the elements of two sequences based on matching keys (right outer join).
+// Each element of 'inner' is paired with each matching element of 'outer'.
+// If there are no matching elements, the element of 'inner' is passed to 'resultSelector' with nil.
+// Order of elements in the result corresponds to the order of elements in 'inner'.
+// reflect.DeepEqual is used to compare keys. 'outer' is enumerated immediately.
+// 'outer' and 'inner' must not be based on the same Enumerator, otherwise use RightJoinSelf instead.
+func RightJoin[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, Inner) Result) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return outerJoin(inner, outer, innerKeySelector, outerKeySelector,
+		func(i *Inner, o *Outer) Result { return resultSelector(o, *i) }, lookupEqFactory[Key](nil), false), nil
+}
+
+// RightJoinMust is like RightJoin but panics in case of error.
+func RightJoinMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, Inner) Result) Enumerator[Result] {
+	r, err := RightJoin(outer, inner, outerKeySelector, innerKeySelector, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// RightJoinSelf correlates the elements of two sequences based on matching keys (right outer join).
+// Each element of 'inner' is paired with each matching element of 'outer'.
+// If there are no matching elements, the element of 'inner' is passed to 'resultSelector' with nil.
+// Order of elements in the result corresponds to the order of elements in 'inner'.
+// reflect.DeepEqual is used to compare keys. 'outer' is enumerated immediately.
+// 'outer' and 'inner' may be based on the same Enumerator.
+// 'inner' must have real Reset method.
+func RightJoinSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, Inner) Result) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return outerJoinSelf(inner, outer, innerKeySelector, outerKeySelector,
+		func(i *Inner, o *Outer) Result { return resultSelector(o, *i) }, lookupEqFactory[Key](nil), false)
+}
+
+// RightJoinSelfMust is like RightJoinSelf but panics in case of error.
+func RightJoinSelfMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, Inner) Result) Enumerator[Result] {
+	r, err := RightJoinSelf(outer, inner, outerKeySelector, innerKeySelector, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// RightJoinEq correlates the elements of two sequences based on matching keys (right outer join).
+// Each element of 'inner' is paired with each matching element of 'outer'.
+// If there are no matching elements, the element of 'inner' is passed to 'resultSelector' with nil.
+// Order of elements in the result corresponds to the order of elements in 'inner'.
+// A specified Equaler is used to compare keys.
+// If 'equaler' is nil reflect.DeepEqual is used. 'outer' is enumerated immediately.
+// 'outer' and 'inner' must not be based on the same Enumerator, otherwise use RightJoinEqSelf instead.
+func RightJoinEq[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, Inner) Result, equaler Equaler[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return outerJoin(inner, outer, innerKeySelector, outerKeySelector,
+		func(i *Inner, o *Outer) Result { return resultSelector(o, *i) }, lookupEqFactory(equaler), false), nil
+}
+
+// RightJoinEqMust is like RightJoinEq but panics in case of error.
+func RightJoinEqMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, Inner) Result, equaler Equaler[Key]) Enumerator[Result] {
+	r, err := RightJoinEq(outer, inner, outerKeySelector, innerKeySelector, resultSelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// RightJoinEqSelf correlates the elements of two sequences based on matching keys (right outer join).
+// Each element of 'inner' is paired with each matching element of 'outer'.
+// If there are no matching elements, the element of 'inner' is passed to 'resultSelector' with nil.
+// Order of elements in the result corresponds to the order of elements in 'inner'.
+// A specified Equaler is used to compare keys.
+// If 'equaler' is nil reflect.DeepEqual is used. 'outer' is enumerated immediately.
+// 'outer' and 'inner' may be based on the same Enumerator.
+// 'inner' must have real Reset method.
+func RightJoinEqSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, Inner) Result, equaler Equaler[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return outerJoinSelf(inner, outer, innerKeySelector, outerKeySelector,
+		func(i *Inner, o *Outer) Result { return resultSelector(o, *i) }, lookupEqFactory(equaler), false)
+}
+
+// RightJoinEqSelfMust is like RightJoinEqSelf but panics in case of error.
+func RightJoinEqSelfMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, Inner) Result, equaler Equaler[Key]) Enumerator[Result] {
+	r, err := RightJoinEqSelf(outer, inner, outerKeySelector, innerKeySelector, resultSelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// RightJoinCmp correlates the elements of two sequences based on matching keys (right outer join).
+// Each element of 'inner' is paired with each matching element of 'outer'.
+// If there are no matching elements, the element of 'inner' is passed to 'resultSelector' with nil.
+// Order of elements in the result corresponds to the order of elements in 'inner'.
+// A specified Comparer is used to compare keys. 'outer' is enumerated immediately.
+// 'outer' and 'inner' must not be based on the same Enumerator, otherwise use RightJoinCmpSelf instead.
+func RightJoinCmp[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, Inner) Result, comparer Comparer[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return outerJoin(inner, outer, innerKeySelector, outerKeySelector,
+		func(i *Inner, o *Outer) Result { return resultSelector(o, *i) }, lookupCmpFactory(comparer), false), nil
+}
+
+// RightJoinCmpMust is like RightJoinCmp but panics in case of error.
+func RightJoinCmpMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, Inner) Result, comparer Comparer[Key]) Enumerator[Result] {
+	r, err := RightJoinCmp(outer, inner, outerKeySelector, innerKeySelector, resultSelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// RightJoinCmpSelf correlates the elements of two sequences based on matching keys (right outer join).
+// Each element of 'inner' is paired with each matching element of 'outer'.
+// If there are no matching elements, the element of 'inner' is passed to 'resultSelector' with nil.
+// Order of elements in the result corresponds to the order of elements in 'inner'.
+// A specified Comparer is used to compare keys. 'outer' is enumerated immediately.
+// 'outer' and 'inner' may be based on the same Enumerator.
+// 'inner' must have real Reset method.
+func RightJoinCmpSelf[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, Inner) Result, comparer Comparer[Key]) (Enumerator[Result], error) {
+	if outer == nil || inner == nil {
+		return nil, ErrNilSource
+	}
+	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return outerJoinSelf(inner, outer, innerKeySelector, outerKeySelector,
+		func(i *Inner, o *Outer) Result { return resultSelector(o, *i) }, lookupCmpFactory(comparer), false)
+}
+
+// RightJoinCmpSelfMust is like RightJoinCmpSelf but panics in case of error.
+func RightJoinCmpSelfMust[Outer, Inner, Key, Result any](outer Enumerator[Outer], inner Enumerator[Inner], outerKeySelector func(Outer) Key,
+	innerKeySelector func(Inner) Key, resultSelector func(*Outer, Inner) Result, comparer Comparer[Key]) Enumerator[Result] {
+	r, err := RightJoinCmpSelf(outer, inner, outerKeySelector, innerKeySelector, resultSelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,84 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_RightJoinMust(t *testing.T) {
+	type args struct {
+		outer            Enumerator[string]
+		inner            Enumerator[string]
+		outerKeySelector func(string) rune
+		innerKeySelector func(string) rune
+	}
+	tests := []struct {
+		name string
+		args args
+		want Enumerator[string]
+	}{
+		{name: "SimpleRightJoin",
+			args: args{
+				outer:            NewOnSlice("first", "second", "third", "sixth"),
+				inner:            NewOnSlice("essence", "offer", "eating", "psalm"),
+				outerKeySelector: func(oel string) rune { return ([]rune(oel))[0] },
+				innerKeySelector: func(iel string) rune { return ([]rune(iel))[1] },
+			},
+			want: NewOnSlice("second:essence", "sixth:essence", "first:offer", "-:eating", "second:psalm", "sixth:psalm"),
+		},
+		{name: "EmptyOuter",
+			args: args{
+				outer:            Empty[string](),
+				inner:            NewOnSlice("first", "second"),
+				outerKeySelector: func(oel string) rune { return ([]rune(oel))[0] },
+				innerKeySelector: func(iel string) rune { return ([]rune(iel))[0] },
+			},
+			want: NewOnSlice("-:first", "-:second"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RightJoinMust(tt.args.outer, tt.args.inner, tt.args.outerKeySelector, tt.args.innerKeySelector,
+				func(oel *string, iel string) string { return joinResult(oel, &iel) })
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("RightJoin() = '%v', want '%v'", String(got), String(tt.want))
+			}
+		})
+	}
+}
+
+func Test_RightJoinEqMust(t *testing.T) {
+	got := RightJoinEqMust(
+		NewOnSlice("ABCxxx", "abcyyy", "defzzz"),
+		NewOnSlice("000abc", "111gHi"),
+		func(oel string) string { return oel[:3] },
+		func(iel string) string { return iel[3:] },
+		func(oel *string, iel string) string { return joinResult(oel, &iel) },
+		CaseInsensitiveEqualer,
+	)
+	want := NewOnSlice("ABCxxx:000abc", "abcyyy:000abc", "-:111gHi")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("RightJoinEq() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_RightJoinCmpSelfMust(t *testing.T) {
+	source := NewOnSlice(1, 2, 3, 4)
+	got := RightJoinCmpSelfMust(source, source,
+		func(oel int) int { return oel * 2 },
+		Identity[int],
+		func(oel *int, iel int) string { return joinResult(oel, &iel) },
+		Order[int]{},
+	)
+	want := NewOnSlice("-:1", "1:2", "-:3", "2:4")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("RightJoinCmpSelf() = '%v', want '%v'", String(got), String(want))
+	}
+}
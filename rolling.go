@@ -0,0 +1,273 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"constraints"
+	"math"
+)
+
+// https://en.wikipedia.org/wiki/Moving_average
+// https://en.wikipedia.org/wiki/Sliding_window_protocol
+
+// rollingSum returns the sums of the values of each window of 'size' consecutive elements
+// the sum is maintained with the Kahan-Babuška (Neumaier) compensated summation,
+// so the floating-point error does not accumulate when the values of different magnitudes enter and leave the window
+// (for integers the compensation is always zero)
+// the non-finite values are not summed but counted, while any of them is in the window the sum is ±Inf or NaN,
+// when the last of them leaves the window the sum is recomputed from the window's finite values
+func rollingSum[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	size int, selector func(Source) Result) Enumerator[Result] {
+	// ring - the values of the current window, the value of the element with the number i is at i%size
+	ring := make([]Result, size)
+	i := 0
+	// sum + comp - the sum of the window's finite values, comp - the compensation of the lost low-order bits
+	var sum, comp Result
+	// posInf, negInf, nan - the numbers of +Inf, -Inf and NaN values in the window
+	var posInf, negInf, nan int
+	abs := func(x Result) Result {
+		if x < 0 {
+			return -x
+		}
+		return x
+	}
+	add := func(v Result) {
+		t := sum + v
+		if abs(sum) >= abs(v) {
+			comp += (sum - t) + v
+		} else {
+			comp += (v - t) + sum
+		}
+		sum = t
+	}
+	// count adds 'd' to the number of the values like 'v' if 'v' is not finite and reports whether 'v' is not finite
+	count := func(v Result, d int) bool {
+		switch {
+		case v != v:
+			nan += d
+		case math.IsInf(float64(v), 1):
+			posInf += d
+		case math.IsInf(float64(v), -1):
+			negInf += d
+		default:
+			return false
+		}
+		return true
+	}
+	return OnFunc[Result]{
+		mvNxt: func() bool {
+			for source.MoveNext() {
+				v := selector(source.Current())
+				if !count(v, 1) {
+					add(v)
+				}
+				old := ring[i%size]
+				ring[i%size] = v
+				if count(old, -1) {
+					if posInf+negInf+nan == 0 {
+						sum, comp = 0, 0
+						for _, r := range ring {
+							add(r)
+						}
+					}
+				} else {
+					add(-old)
+				}
+				i++
+				if i >= size {
+					return true
+				}
+			}
+			return false
+		},
+		crrnt: func() Result {
+			switch {
+			case nan > 0 || (posInf > 0 && negInf > 0):
+				return Result(math.NaN())
+			case posInf > 0:
+				return Result(math.Inf(1))
+			case negInf > 0:
+				return Result(math.Inf(-1))
+			}
+			return sum + comp
+		},
+		rst: func() {
+			for j := range ring {
+				ring[j] = 0
+			}
+			i, sum, comp = 0, 0, 0
+			posInf, negInf, nan = 0, 0, 0
+			source.Reset()
+		},
+		err: func() error { return Err(source) },
+		cls: func() error { return Close(source) },
+	}
+}
+
+// RollingSum computes the sums of the values of each window of 'size' consecutive elements
+// (see Window). The values are obtained by invoking a transform function on each element of the input sequence.
+// The sum is updated incrementally, so each element is processed in O(1).
+// Compensated summation is used, so the result does not drift
+// when the values of very different magnitudes pass through the window.
+// While ±Inf or NaN is in the window, the sum is ±Inf or NaN (NaN if both +Inf and -Inf are in the window),
+// the following windows without them are summed correctly.
+// The incomplete windows at the beginning of the sequence are not summed,
+// so the sequence of n elements produces n-size+1 results.
+func RollingSum[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	size int, selector func(Source) Result) (Enumerator[Result], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if size <= 0 {
+		return nil, ErrSizeOutOfRange
+	}
+	if selector == nil {
+		return nil, ErrNilSelector
+	}
+	return rollingSum(source, size, selector), nil
+}
+
+// RollingSumMust is like RollingSum but panics in case of error.
+func RollingSumMust[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	size int, selector func(Source) Result) Enumerator[Result] {
+	r, err := RollingSum(source, size, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// RollingAverage computes the averages of the values of each window of 'size' consecutive elements
+// (the simple moving average, see RollingSum).
+func RollingAverage[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	size int, selector func(Source) Result) (Enumerator[float64], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if size <= 0 {
+		return nil, ErrSizeOutOfRange
+	}
+	if selector == nil {
+		return nil, ErrNilSelector
+	}
+	return SelectMust(rollingSum(source, size, selector), func(sum Result) float64 {
+		return float64(sum) / float64(size)
+	}), nil
+}
+
+// RollingAverageMust is like RollingAverage but panics in case of error.
+func RollingAverageMust[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	size int, selector func(Source) Result) Enumerator[float64] {
+	r, err := RollingAverage(source, size, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// rollingEntry is a value with the number of the element it was obtained from
+type rollingEntry[T any] struct {
+	v T
+	i int
+}
+
+// rollingExtremum returns the extremums of the values of each window of 'size' consecutive elements.
+// The extremum is the least value according to 'less' (the earliest one in case of ties).
+//
+// A monotonic deque is used: it holds the candidates for the extremum of the current and the next windows
+// in the order of the elements, their values are increasing according to 'less',
+// so the extremum of the window is the front of the deque and each element is processed in amortized O(1).
+func rollingExtremum[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	size int, selector func(Source) Result, less func(Result, Result) bool) Enumerator[Result] {
+	// dq[head:] is the deque
+	var dq []rollingEntry[Result]
+	head, i := 0, 0
+	return OnFunc[Result]{
+		mvNxt: func() bool {
+			for source.MoveNext() {
+				v := selector(source.Current())
+				for len(dq) > head && !less(dq[len(dq)-1].v, v) {
+					dq = dq[:len(dq)-1]
+				}
+				dq = append(dq, rollingEntry[Result]{v: v, i: i})
+				if dq[head].i <= i-size {
+					head++
+				}
+				if head > size {
+					// compact the deque
+					dq = dq[:copy(dq, dq[head:])]
+					head = 0
+				}
+				i++
+				if i >= size {
+					return true
+				}
+			}
+			return false
+		},
+		crrnt: func() Result {
+			if head >= len(dq) {
+				return 0
+			}
+			return dq[head].v
+		},
+		rst: func() {
+			dq, head, i = dq[:0], 0, 0
+			source.Reset()
+		},
+		err: func() error { return Err(source) },
+		cls: func() error { return Close(source) },
+	}
+}
+
+// RollingMin computes the minimums of the values of each window of 'size' consecutive elements
+// (see RollingSum). Each element is processed in amortized O(1) with the help of a monotonic deque.
+func RollingMin[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	size int, selector func(Source) Result) (Enumerator[Result], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if size <= 0 {
+		return nil, ErrSizeOutOfRange
+	}
+	if selector == nil {
+		return nil, ErrNilSelector
+	}
+	return rollingExtremum(source, size, selector, func(x, y Result) bool { return x < y }), nil
+}
+
+// RollingMinMust is like RollingMin but panics in case of error.
+func RollingMinMust[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	size int, selector func(Source) Result) Enumerator[Result] {
+	r, err := RollingMin(source, size, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// RollingMax computes the maximums of the values of each window of 'size' consecutive elements
+// (see RollingSum). Each element is processed in amortized O(1) with the help of a monotonic deque.
+func RollingMax[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	size int, selector func(Source) Result) (Enumerator[Result], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if size <= 0 {
+		return nil, ErrSizeOutOfRange
+	}
+	if selector == nil {
+		return nil, ErrNilSelector
+	}
+	return rollingExtremum(source, size, selector, func(x, y Result) bool { return x > y }), nil
+}
+
+// RollingMaxMust is like RollingMax but panics in case of error.
+func RollingMaxMust[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	size int, selector func(Source) Result) Enumerator[Result] {
+	r, err := RollingMax(source, size, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
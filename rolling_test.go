@@ -0,0 +1,183 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"math"
+	"testing"
+)
+
+func Test_RollingSumMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		source Enumerator[int]
+		size   int
+		want   Enumerator[int]
+	}{
+		{name: "EmptySource", source: Empty[int](), size: 2, want: Empty[int]()},
+		{name: "ShortSource", source: NewOnSlice(1, 2), size: 3, want: Empty[int]()},
+		{name: "Size1", source: NewOnSlice(1, 2, 3), size: 1, want: NewOnSlice(1, 2, 3)},
+		{name: "Size3", source: NewOnSlice(1, 2, 3, 4, 5), size: 3, want: NewOnSlice(6, 9, 12)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RollingSumMust(tt.source, tt.size, Identity[int])
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("RollingSum() = '%v', want '%v'", String(got), String(tt.want))
+			}
+			got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(got, tt.want) {
+				t.Errorf("RollingSum() after Reset differs")
+			}
+		})
+	}
+	if _, err := RollingSum(NewOnSliceEn(1), 0, Identity[int]); err != ErrSizeOutOfRange {
+		t.Errorf("RollingSum() error = '%v', want '%v'", err, ErrSizeOutOfRange)
+	}
+	if _, err := RollingSum[int, int](NewOnSliceEn(1), 1, nil); err != ErrNilSelector {
+		t.Errorf("RollingSum() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+}
+
+func Test_RollingSum_mixedMagnitude(t *testing.T) {
+	ff := []float64{1e16, 1, 1, 1, -1e16, 1, 1, 0.1, 0.2, 0.3}
+	got := Slice(RollingSumMust(NewOnSliceEn(ff...), 2, Identity[float64]))
+	for i := range got {
+		if want := ff[i] + ff[i+1]; got[i] != want {
+			t.Errorf("RollingSum()[%d] = %v, want %v", i, got[i], want)
+		}
+	}
+	// a long sequence with huge values entering and leaving the window
+	var long []float64
+	for i := 0; i < 10000; i++ {
+		if i%100 == 0 {
+			long = append(long, 1e20)
+		} else {
+			long = append(long, 0.1)
+		}
+	}
+	got = Slice(RollingSumMust(NewOnSliceEn(long...), 3, Identity[float64]))
+	for i := range got {
+		if want := long[i] + long[i+1] + long[i+2]; math.Abs(got[i]-want) > 1e-9*math.Max(1, math.Abs(want)) {
+			t.Errorf("RollingSum()[%d] = %v, want %v", i, got[i], want)
+		}
+	}
+}
+
+func Test_RollingSum_nonFinite(t *testing.T) {
+	inf, nan := math.Inf(1), math.NaN()
+	tests := []struct {
+		name string
+		ff   []float64
+		want []float64
+	}{
+		{name: "PosInf", ff: []float64{1, inf, 1, 2, 3}, want: []float64{inf, inf, 3, 5}},
+		{name: "NegInf", ff: []float64{-inf, 1, 2, 0.1, 0.2}, want: []float64{-inf, 3, 2.1, 0.30000000000000004}},
+		{name: "NaN", ff: []float64{1, nan, 2, 3}, want: []float64{nan, nan, 5}},
+		{name: "PosNegInf", ff: []float64{inf, -inf, 1, 2}, want: []float64{nan, -inf, 3}},
+		{name: "OnlyNonFinite", ff: []float64{inf, inf, nan, 4, 5}, want: []float64{inf, nan, nan, 9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Slice(RollingSumMust(NewOnSliceEn(tt.ff...), 2, Identity[float64]))
+			if len(got) != len(tt.want) {
+				t.Fatalf("RollingSum() = '%v', want '%v'", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] && !(math.IsNaN(got[i]) && math.IsNaN(tt.want[i])) {
+					t.Errorf("RollingSum() = '%v', want '%v'", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func Test_RollingAverageMust(t *testing.T) {
+	got := RollingAverageMust(NewOnSliceEn("a", "bb", "cccc", "dddddd"), 2, func(s string) int { return len(s) })
+	want := NewOnSliceEn(1.5, 3.0, 5.0)
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("RollingAverage() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_RollingMinMaxMust(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  Enumerator[float64]
+		size    int
+		wantMin Enumerator[float64]
+		wantMax Enumerator[float64]
+	}{
+		{name: "EmptySource", source: Empty[float64](), size: 2, wantMin: Empty[float64](), wantMax: Empty[float64]()},
+		{name: "Size1",
+			source:  NewOnSlice(3.0, 1.0, 2.0),
+			size:    1,
+			wantMin: NewOnSlice(3.0, 1.0, 2.0),
+			wantMax: NewOnSlice(3.0, 1.0, 2.0),
+		},
+		{name: "Size3",
+			source:  NewOnSlice(1.0, 3.0, -1.0, -3.0, 5.0, 3.0, 6.0, 7.0),
+			size:    3,
+			wantMin: NewOnSlice(-1.0, -3.0, -3.0, -3.0, 3.0, 3.0),
+			wantMax: NewOnSlice(3.0, 3.0, 5.0, 5.0, 6.0, 7.0),
+		},
+		{name: "Duplicates",
+			source:  NewOnSlice(2.0, 2.0, 2.0, 1.0, 1.0),
+			size:    2,
+			wantMin: NewOnSlice(2.0, 2.0, 1.0, 1.0),
+			wantMax: NewOnSlice(2.0, 2.0, 2.0, 1.0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMin := RollingMinMust(tt.source, tt.size, Identity[float64])
+			if !SequenceEqualMust(gotMin, tt.wantMin) {
+				gotMin.Reset()
+				tt.wantMin.Reset()
+				t.Errorf("RollingMin() = '%v', want '%v'", String(gotMin), String(tt.wantMin))
+			}
+			tt.source.Reset()
+			gotMax := RollingMaxMust(tt.source, tt.size, Identity[float64])
+			if !SequenceEqualMust(gotMax, tt.wantMax) {
+				gotMax.Reset()
+				tt.wantMax.Reset()
+				t.Errorf("RollingMax() = '%v', want '%v'", String(gotMax), String(tt.wantMax))
+			}
+			gotMax.Reset()
+			tt.wantMax.Reset()
+			if !SequenceEqualMust(gotMax, tt.wantMax) {
+				t.Errorf("RollingMax() after Reset differs")
+			}
+		})
+	}
+}
+
+func Test_RollingMin_long(t *testing.T) {
+	// compare with the naive computation on a longer sequence to exercise the deque compaction
+	const n, size = 200, 7
+	sl := make([]int, n)
+	for i := range sl {
+		sl[i] = (i * 37) % 101
+	}
+	got := Slice(RollingMinMust(NewOnSliceEn(sl...), size, Identity[int]))
+	if len(got) != n-size+1 {
+		t.Fatalf("RollingMin() returned %d elements, want %d", len(got), n-size+1)
+	}
+	for i := range got {
+		want := sl[i]
+		for _, v := range sl[i : i+size] {
+			if v < want {
+				want = v
+			}
+		}
+		if got[i] != want {
+			t.Errorf("RollingMin()[%d] = %d, want %d", i, got[i], want)
+		}
+	}
+}
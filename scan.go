@@ -0,0 +1,137 @@
+//go:build go1.18
+
+package go2linq
+
+// https://morelinq.github.io/3.3/ref/api/html/Overload_MoreLinq_MoreEnumerable_Scan.htm
+// https://morelinq.github.io/3.3/ref/api/html/M_MoreLinq_MoreEnumerable_PreScan__1.htm
+// https://en.wikipedia.org/wiki/Prefix_sum
+
+// Scan applies an accumulator function over a sequence and returns each intermediate accumulator value
+// (inclusive scan, the running counterpart of Aggregate).
+// The first element of the sequence is returned as is and is used as the initial accumulator value.
+func Scan[Source any](source Enumerator[Source], accumulator func(Source, Source) Source) (Enumerator[Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if accumulator == nil {
+		return nil, ErrNilAccumulator
+	}
+	var acc Source
+	started := false
+	return OnFunc[Source]{
+			mvNxt: func() bool {
+				if !source.MoveNext() {
+					return false
+				}
+				if started {
+					acc = accumulator(acc, source.Current())
+				} else {
+					acc, started = source.Current(), true
+				}
+				return true
+			},
+			crrnt: func() Source { return acc },
+			rst: func() {
+				started = false
+				source.Reset()
+			},
+			err: func() error { return Err(source) },
+			cls: func() error { return Close(source) },
+		},
+		nil
+}
+
+// ScanMust is like Scan but panics in case of error.
+func ScanMust[Source any](source Enumerator[Source], accumulator func(Source, Source) Source) Enumerator[Source] {
+	r, err := Scan(source, accumulator)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ScanSeed applies an accumulator function over a sequence and returns each intermediate accumulator value
+// (the running counterpart of AggregateSeed).
+// The specified seed value is used as the initial accumulator value (the seed itself is not returned).
+func ScanSeed[Source, Accumulate any](source Enumerator[Source],
+	seed Accumulate, accumulator func(Accumulate, Source) Accumulate) (Enumerator[Accumulate], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if accumulator == nil {
+		return nil, ErrNilAccumulator
+	}
+	acc := seed
+	return OnFunc[Accumulate]{
+			mvNxt: func() bool {
+				if !source.MoveNext() {
+					return false
+				}
+				acc = accumulator(acc, source.Current())
+				return true
+			},
+			crrnt: func() Accumulate { return acc },
+			rst: func() {
+				acc = seed
+				source.Reset()
+			},
+			err: func() error { return Err(source) },
+			cls: func() error { return Close(source) },
+		},
+		nil
+}
+
+// ScanSeedMust is like ScanSeed but panics in case of error.
+func ScanSeedMust[Source, Accumulate any](source Enumerator[Source],
+	seed Accumulate, accumulator func(Accumulate, Source) Accumulate) Enumerator[Accumulate] {
+	r, err := ScanSeed(source, seed, accumulator)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// PreScan applies an accumulator function over a sequence and returns the accumulator value
+// before each element is accumulated (exclusive scan).
+// The first returned value is 'seed', the value after the last element is not returned,
+// so the result has the same number of elements as the sequence.
+// E.g. PreScan of 1, 2, 3 with seed 0 and addition returns 0, 1, 3.
+func PreScan[Source, Accumulate any](source Enumerator[Source],
+	seed Accumulate, accumulator func(Accumulate, Source) Accumulate) (Enumerator[Accumulate], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if accumulator == nil {
+		return nil, ErrNilAccumulator
+	}
+	// acc - the value to return, next - the value after the current element is accumulated
+	acc, next := seed, seed
+	return OnFunc[Accumulate]{
+			mvNxt: func() bool {
+				if !source.MoveNext() {
+					return false
+				}
+				acc = next
+				next = accumulator(next, source.Current())
+				return true
+			},
+			crrnt: func() Accumulate { return acc },
+			rst: func() {
+				acc, next = seed, seed
+				source.Reset()
+			},
+			err: func() error { return Err(source) },
+			cls: func() error { return Close(source) },
+		},
+		nil
+}
+
+// PreScanMust is like PreScan but panics in case of error.
+func PreScanMust[Source, Accumulate any](source Enumerator[Source],
+	seed Accumulate, accumulator func(Accumulate, Source) Accumulate) Enumerator[Accumulate] {
+	r, err := PreScan(source, seed, accumulator)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
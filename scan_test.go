@@ -0,0 +1,103 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_ScanMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		source Enumerator[int]
+		want   Enumerator[int]
+	}{
+		{name: "EmptySource", source: Empty[int](), want: Empty[int]()},
+		{name: "SingleElement", source: NewOnSlice(5), want: NewOnSlice(5)},
+		{name: "RunningSum", source: NewOnSlice(1, 2, 3, 4), want: NewOnSlice(1, 3, 6, 10)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ScanMust(tt.source, func(acc, el int) int { return acc + el })
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("Scan() = '%v', want '%v'", String(got), String(tt.want))
+			}
+			got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(got, tt.want) {
+				t.Errorf("Scan() after Reset differs")
+			}
+		})
+	}
+	if _, err := Scan[int](NewOnSliceEn(1), nil); err != ErrNilAccumulator {
+		t.Errorf("Scan() error = '%v', want '%v'", err, ErrNilAccumulator)
+	}
+}
+
+func Test_ScanSeedMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		source Enumerator[string]
+		want   Enumerator[int]
+	}{
+		{name: "EmptySource", source: Empty[string](), want: Empty[int]()},
+		{name: "RunningLength", source: NewOnSlice("a", "bb", "ccc"), want: NewOnSlice(11, 13, 16)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ScanSeedMust(tt.source, 10, func(acc int, el string) int { return acc + len(el) })
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("ScanSeed() = '%v', want '%v'", String(got), String(tt.want))
+			}
+			got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(got, tt.want) {
+				t.Errorf("ScanSeed() after Reset differs")
+			}
+		})
+	}
+	if _, err := ScanSeed[int, int](NewOnSliceEn(1), 0, nil); err != ErrNilAccumulator {
+		t.Errorf("ScanSeed() error = '%v', want '%v'", err, ErrNilAccumulator)
+	}
+}
+
+func Test_PreScanMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		source Enumerator[int]
+		want   Enumerator[int]
+	}{
+		{name: "EmptySource", source: Empty[int](), want: Empty[int]()},
+		{name: "SingleElement", source: NewOnSlice(5), want: NewOnSlice(0)},
+		{name: "ExclusiveSum", source: NewOnSlice(1, 2, 3, 4), want: NewOnSlice(0, 1, 3, 6)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PreScanMust(tt.source, 0, func(acc, el int) int { return acc + el })
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("PreScan() = '%v', want '%v'", String(got), String(tt.want))
+			}
+			got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(got, tt.want) {
+				t.Errorf("PreScan() after Reset differs")
+			}
+		})
+	}
+	if _, err := PreScan[int, int](NewOnSliceEn(1), 0, nil); err != ErrNilAccumulator {
+		t.Errorf("PreScan() error = '%v', want '%v'", err, ErrNilAccumulator)
+	}
+}
+
+func Test_Scan_error(t *testing.T) {
+	got := ScanMust[int](failAt(NewOnSliceEn(1, 2, 3, 4), 3), func(acc, el int) int { return acc + el })
+	if _, err := SliceErr(got); err != errTest {
+		t.Errorf("Scan() error = '%v', want '%v'", err, errTest)
+	}
+}
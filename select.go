@@ -18,6 +18,8 @@ func Select[Source, Result any](source Enumerator[Source], selector func(Source)
 			mvNxt: func() bool { return source.MoveNext() },
 			crrnt: func() Result { return selector(source.Current()) },
 			rst:   func() { source.Reset() },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
 		},
 		nil
 }
@@ -44,6 +46,8 @@ func SelectIdx[Source, Result any](source Enumerator[Source], selector func(Sour
 			mvNxt: func() bool { i++; return source.MoveNext() },
 			crrnt: func() Result { return selector(source.Current(), i) },
 			rst:   func() { i = -1; source.Reset() },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
 		},
 		nil
 }
@@ -56,3 +60,40 @@ func SelectIdxMust[Source, Result any](source Enumerator[Source], selector func(
 	}
 	return r
 }
+
+// SelectErr projects each element of a sequence into a new form using a fallible selector.
+// The first error returned by 'selector' stops the enumeration
+// and is reported by the Err method of the resulting ErrEnumerator.
+func SelectErr[Source, Result any](source Enumerator[Source], selector func(Source) (Result, error)) (ErrEnumerator[Result], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if selector == nil {
+		return nil, ErrNilSelector
+	}
+	var c Result
+	var serr error
+	return OnFunc[Result]{
+			mvNxt: func() bool {
+				if serr != nil || !source.MoveNext() {
+					return false
+				}
+				c, serr = selector(source.Current())
+				return serr == nil
+			},
+			crrnt: func() Result { return c },
+			rst:   func() { serr = nil; source.Reset() },
+			err:   func() error { return firstErr(serr, Err(source)) },
+			cls:   func() error { return Close(source) },
+		},
+		nil
+}
+
+// SelectErrMust is like SelectErr but panics in case of error.
+func SelectErrMust[Source, Result any](source Enumerator[Source], selector func(Source) (Result, error)) ErrEnumerator[Result] {
+	r, err := SelectErr(source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
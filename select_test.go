@@ -4,6 +4,7 @@ package go2linq
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -188,3 +189,79 @@ func Test_SelectIdx_int_int(t *testing.T) {
 		})
 	}
 }
+
+func Test_SelectErr_int_string(t *testing.T) {
+	type args struct {
+		source   Enumerator[int]
+		selector func(int) (string, error)
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr error
+	}{
+		{name: "NoError",
+			args: args{
+				source:   NewOnSlice(1, 2, 3),
+				selector: func(i int) (string, error) { return fmt.Sprint(i), nil },
+			},
+			want: []string{"1", "2", "3"},
+		},
+		{name: "ErrorStopsEnumeration",
+			args: args{
+				source: NewOnSlice(1, 2, 3),
+				selector: func(i int) (string, error) {
+					if i == 2 {
+						return "", errTest
+					}
+					return fmt.Sprint(i), nil
+				},
+			},
+			want:    []string{"1"},
+			wantErr: errTest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SelectErrMust(tt.args.source, tt.args.selector)
+			var sl []string
+			for got.MoveNext() {
+				sl = append(sl, got.Current())
+			}
+			if !reflect.DeepEqual(sl, tt.want) {
+				t.Errorf("SelectErr() = '%v', want '%v'", sl, tt.want)
+			}
+			if got.MoveNext() {
+				t.Errorf("SelectErr().MoveNext() after error = true")
+			}
+			if err := got.Err(); err != tt.wantErr {
+				t.Errorf("SelectErr().Err() = '%v', wantErr '%v'", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_SelectErr_Pipeline(t *testing.T) {
+	en := SelectMust(WhereMust(failAt(RangeMust(1, 10), 5), func(i int) bool { return i%2 == 1 }), func(i int) int { return i * 10 })
+	got, err := SliceErr(en)
+	if err != errTest {
+		t.Errorf("SliceErr() error = '%v', want '%v'", err, errTest)
+	}
+	if got != nil {
+		t.Errorf("SliceErr() = '%v', want nil", got)
+	}
+	if _, err := Count(failAt(RangeMust(1, 10), 5)); err != errTest {
+		t.Errorf("Count() error = '%v', want '%v'", err, errTest)
+	}
+	if _, err := FirstOrDefaultPred(failAt(RangeMust(1, 10), 5), func(i int) bool { return i > 7 }); err != errTest {
+		t.Errorf("FirstOrDefaultPred() error = '%v', want '%v'", err, errTest)
+	}
+	if _, err := OrderByLs(failAt(RangeMust(1, 10), 5), Identity[int], Lesser[int](Order[int]{})); err != nil {
+		t.Errorf("OrderByLs() error = '%v'", err)
+	}
+	oe := OrderByLsMust(failAt(RangeMust(1, 10), 5), Identity[int], Lesser[int](Order[int]{}))
+	if _, err := SliceErr(oe.GetEnumerator()); err != errTest {
+		t.Errorf("SliceErr(OrderByLs()) error = '%v', want '%v'", err, errTest)
+	}
+}
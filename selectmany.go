@@ -15,13 +15,22 @@ func SelectMany[Source, Result any](source Enumerator[Source], selector func(Sou
 	if selector == nil {
 		return nil, ErrNilSelector
 	}
+	var terr error
 	t := Empty[Result]()
 	return OnFunc[Result]{
 			mvNxt: func() bool {
+				if terr != nil {
+					return false
+				}
 				for {
 					if t.MoveNext() {
 						return true
 					}
+					if terr = Err(t); terr != nil {
+						return false
+					}
+					Close(t)
+					t = Empty[Result]()
 					if !source.MoveNext() {
 						return false
 					}
@@ -30,7 +39,9 @@ func SelectMany[Source, Result any](source Enumerator[Source], selector func(Sou
 			},
 			crrnt: func() Result { return t.Current() },
 			//		crrnt: t.Current, // yields wrong results
-			rst: func() { t = Empty[Result](); source.Reset() },
+			rst: func() { terr = nil; t = Empty[Result](); source.Reset() },
+			err: func() error { return firstErr(terr, Err(source)) },
+			cls: func() error { return firstErr(Close(t), Close(source)) },
 		},
 		nil
 }
@@ -54,13 +65,22 @@ func SelectManyIdx[Source, Result any](source Enumerator[Source], selector func(
 		return nil, ErrNilSelector
 	}
 	i := -1
+	var terr error
 	t := Empty[Result]()
 	return OnFunc[Result]{
 			mvNxt: func() bool {
+				if terr != nil {
+					return false
+				}
 				for {
 					if t.MoveNext() {
 						return true
 					}
+					if terr = Err(t); terr != nil {
+						return false
+					}
+					Close(t)
+					t = Empty[Result]()
 					if !source.MoveNext() {
 						return false
 					}
@@ -69,7 +89,9 @@ func SelectManyIdx[Source, Result any](source Enumerator[Source], selector func(
 				}
 			},
 			crrnt: func() Result { return t.Current() },
-			rst:   func() { i = -1; t = Empty[Result](); source.Reset() },
+			rst:   func() { terr = nil; i = -1; t = Empty[Result](); source.Reset() },
+			err:   func() error { return firstErr(terr, Err(source)) },
+			cls:   func() error { return firstErr(Close(t), Close(source)) },
 		},
 		nil
 }
@@ -95,13 +117,22 @@ func SelectManyColl[Source, Collection, Result any](source Enumerator[Source],
 		return nil, ErrNilSelector
 	}
 	var e1 Source
+	var terr error
 	t := Empty[Collection]()
 	return OnFunc[Result]{
 			mvNxt: func() bool {
+				if terr != nil {
+					return false
+				}
 				for {
 					if t.MoveNext() {
 						return true
 					}
+					if terr = Err(t); terr != nil {
+						return false
+					}
+					Close(t)
+					t = Empty[Collection]()
 					if !source.MoveNext() {
 						return false
 					}
@@ -110,7 +141,9 @@ func SelectManyColl[Source, Collection, Result any](source Enumerator[Source],
 				}
 			},
 			crrnt: func() Result { return resultSelector(e1, t.Current()) },
-			rst:   func() { t = Empty[Collection](); source.Reset() },
+			rst:   func() { terr = nil; t = Empty[Collection](); source.Reset() },
+			err:   func() error { return firstErr(terr, Err(source)) },
+			cls:   func() error { return firstErr(Close(t), Close(source)) },
 		},
 		nil
 }
@@ -138,13 +171,22 @@ func SelectManyCollIdx[Source, Collection, Result any](source Enumerator[Source]
 	}
 	var e1 Source
 	i := -1
+	var terr error
 	t := Empty[Collection]()
 	return OnFunc[Result]{
 			mvNxt: func() bool {
+				if terr != nil {
+					return false
+				}
 				for {
 					if t.MoveNext() {
 						return true
 					}
+					if terr = Err(t); terr != nil {
+						return false
+					}
+					Close(t)
+					t = Empty[Collection]()
 					if !source.MoveNext() {
 						return false
 					}
@@ -154,7 +196,9 @@ func SelectManyCollIdx[Source, Collection, Result any](source Enumerator[Source]
 				}
 			},
 			crrnt: func() Result { return resultSelector(e1, t.Current()) },
-			rst:   func() { i = -1; t = Empty[Collection](); source.Reset() },
+			rst:   func() { terr = nil; i = -1; t = Empty[Collection](); source.Reset() },
+			err:   func() error { return firstErr(terr, Err(source)) },
+			cls:   func() error { return firstErr(Close(t), Close(source)) },
 		},
 		nil
 }
@@ -168,3 +212,57 @@ func SelectManyCollIdxMust[Source, Collection, Result any](source Enumerator[Sou
 	}
 	return r
 }
+
+// SelectManyErr projects each element of a sequence to an Enumerator using a fallible selector
+// and flattens the resulting sequences into one sequence.
+// The first error returned by 'selector' stops the enumeration
+// and is reported by the Err method of the resulting ErrEnumerator.
+func SelectManyErr[Source, Result any](source Enumerator[Source], selector func(Source) (Enumerator[Result], error)) (ErrEnumerator[Result], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if selector == nil {
+		return nil, ErrNilSelector
+	}
+	var terr error
+	t := Empty[Result]()
+	return OnFunc[Result]{
+			mvNxt: func() bool {
+				if terr != nil {
+					return false
+				}
+				for {
+					if t.MoveNext() {
+						return true
+					}
+					if terr = Err(t); terr != nil {
+						return false
+					}
+					Close(t)
+					t = Empty[Result]()
+					if !source.MoveNext() {
+						return false
+					}
+					t, terr = selector(source.Current())
+					if terr != nil {
+						t = Empty[Result]()
+						return false
+					}
+				}
+			},
+			crrnt: func() Result { return t.Current() },
+			rst:   func() { terr = nil; t = Empty[Result](); source.Reset() },
+			err:   func() error { return firstErr(terr, Err(source)) },
+			cls:   func() error { return firstErr(Close(t), Close(source)) },
+		},
+		nil
+}
+
+// SelectManyErrMust is like SelectManyErr but panics in case of error.
+func SelectManyErrMust[Source, Result any](source Enumerator[Source], selector func(Source) (Enumerator[Result], error)) ErrEnumerator[Result] {
+	r, err := SelectManyErr(source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -4,6 +4,7 @@ package go2linq
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -217,3 +218,33 @@ func Test_SelectManyCollIdx_int_rune_string(t *testing.T) {
 		})
 	}
 }
+
+func Test_SelectManyErr_int(t *testing.T) {
+	got := SelectManyErrMust(NewOnSliceEn(1, 2, 3), func(i int) (Enumerator[int], error) {
+		if i == 3 {
+			return nil, errTest
+		}
+		return RepeatMust(i, i), nil
+	})
+	var sl []int
+	for got.MoveNext() {
+		sl = append(sl, got.Current())
+	}
+	if !reflect.DeepEqual(sl, []int{1, 2, 2}) {
+		t.Errorf("SelectManyErr() = '%v', want '%v'", sl, []int{1, 2, 2})
+	}
+	if err := got.Err(); err != errTest {
+		t.Errorf("SelectManyErr().Err() = '%v', want '%v'", err, errTest)
+	}
+}
+
+func Test_SelectMany_InnerErr(t *testing.T) {
+	got := SelectManyMust(NewOnSliceEn(1, 9), func(i int) Enumerator[int] { return failAt(RangeMust(i, 3), 10) })
+	sl, err := SliceErr(got)
+	if err != errTest {
+		t.Errorf("SelectMany() error = '%v', want '%v'", err, errTest)
+	}
+	if sl != nil {
+		t.Errorf("SelectMany() = '%v', want nil", sl)
+	}
+}
@@ -0,0 +1,240 @@
+//go:build go1.23
+
+package go2linq
+
+import (
+	"iter"
+)
+
+// https://go.dev/blog/range-functions
+// https://pkg.go.dev/iter
+
+// FromSeq creates a new Enumerator based on the provided iter.Seq.
+// FromSeq returns an empty Enumerator if 'seq' is nil.
+//
+// 'seq' is iterated in the pull manner (see iter.Pull).
+// Close (or enumeration to the end) releases the resources held by the underlying pull iterator,
+// so Close the returned Enumerator if it is abandoned before the end.
+// Reset restarts iteration of 'seq'.
+func FromSeq[T any](seq iter.Seq[T]) Enumerator[T] {
+	if seq == nil {
+		return Empty[T]()
+	}
+	var next func() (T, bool)
+	var stop func()
+	var c T
+	stp := func() {
+		if stop != nil {
+			stop()
+		}
+	}
+	return OnFunc[T]{
+		mvNxt: func() bool {
+			if next == nil {
+				next, stop = iter.Pull(seq)
+			}
+			var ok bool
+			c, ok = next()
+			if !ok {
+				stp()
+			}
+			return ok
+		},
+		crrnt: func() T { return c },
+		rst: func() {
+			stp()
+			next, stop = nil, nil
+			c = ZeroValue[T]()
+		},
+		cls: func() error {
+			stp()
+			return nil
+		},
+	}
+}
+
+// FromSeq2 creates a new Enumerator of KeyElements based on the provided iter.Seq2.
+// FromSeq2 returns an empty Enumerator if 'seq' is nil.
+//
+// 'seq' is iterated in the pull manner (see iter.Pull2), see FromSeq for details.
+func FromSeq2[K, V any](seq iter.Seq2[K, V]) Enumerator[KeyElement[K, V]] {
+	if seq == nil {
+		return Empty[KeyElement[K, V]]()
+	}
+	var next func() (K, V, bool)
+	var stop func()
+	var c KeyElement[K, V]
+	stp := func() {
+		if stop != nil {
+			stop()
+		}
+	}
+	return OnFunc[KeyElement[K, V]]{
+		mvNxt: func() bool {
+			if next == nil {
+				next, stop = iter.Pull2(seq)
+			}
+			k, v, ok := next()
+			if !ok {
+				stp()
+				return false
+			}
+			c = KeyElement[K, V]{key: k, element: v}
+			return true
+		},
+		crrnt: func() KeyElement[K, V] { return c },
+		rst: func() {
+			stp()
+			next, stop = nil, nil
+			c = KeyElement[K, V]{}
+		},
+		cls: func() error {
+			stp()
+			return nil
+		},
+	}
+}
+
+// FromSeqIdx creates a new Enumerator based on the values of the provided indexed iter.Seq2
+// (e.g. the one returned by slices.All). The indexes are discarded.
+// FromSeqIdx returns an empty Enumerator if 'seq' is nil.
+func FromSeqIdx[T any](seq iter.Seq2[int, T]) Enumerator[T] {
+	return SelectMust(FromSeq2(seq), func(ke KeyElement[int, T]) T { return ke.element })
+}
+
+// ToSeq creates a new iter.Seq based on the provided Enumerator.
+//
+// Each iteration of the returned iter.Seq enumerates 'en' from its current position.
+// If the iteration is stopped early (e.g. 'break' in 'for range' loop) 'en' is closed (see Close).
+// Use Err to check whether the iteration has been stopped by an error.
+func ToSeq[T any](en Enumerator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if en == nil {
+			return
+		}
+		for en.MoveNext() {
+			if !yield(en.Current()) {
+				Close(en)
+				return
+			}
+		}
+	}
+}
+
+// ToSeq2 creates a new iter.Seq2 based on the provided Enumerator of KeyElements.
+//
+// See ToSeq for details.
+func ToSeq2[K, V any](en Enumerator[KeyElement[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if en == nil {
+			return
+		}
+		for en.MoveNext() {
+			c := en.Current()
+			if !yield(c.key, c.element) {
+				Close(en)
+				return
+			}
+		}
+	}
+}
+
+// ToSeqIdx creates a new indexed iter.Seq2 based on the provided Enumerator.
+// The indexes start from zero on each iteration of the returned iter.Seq2.
+//
+// See ToSeq for details.
+func ToSeqIdx[T any](en Enumerator[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if en == nil {
+			return
+		}
+		i := 0
+		for en.MoveNext() {
+			if !yield(i, en.Current()) {
+				Close(en)
+				return
+			}
+			i++
+		}
+	}
+}
+
+// seqOf creates a new iter.Seq each iteration of which enumerates a new Enumerator created by 'f'.
+func seqOf[T any](f func() Enumerator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		en := f()
+		defer Close(en)
+		for en.MoveNext() {
+			if !yield(en.Current()) {
+				return
+			}
+		}
+	}
+}
+
+// WhereSeq is like Where but works with iter.Seq.
+func WhereSeq[Source any](seq iter.Seq[Source], predicate func(Source) bool) (iter.Seq[Source], error) {
+	if seq == nil {
+		return nil, ErrNilSource
+	}
+	if predicate == nil {
+		return nil, ErrNilPredicate
+	}
+	return seqOf(func() Enumerator[Source] { return WhereMust(FromSeq(seq), predicate) }), nil
+}
+
+// WhereSeqMust is like WhereSeq but panics in case of error.
+func WhereSeqMust[Source any](seq iter.Seq[Source], predicate func(Source) bool) iter.Seq[Source] {
+	r, err := WhereSeq(seq, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SelectSeq is like Select but works with iter.Seq.
+func SelectSeq[Source, Result any](seq iter.Seq[Source], selector func(Source) Result) (iter.Seq[Result], error) {
+	if seq == nil {
+		return nil, ErrNilSource
+	}
+	if selector == nil {
+		return nil, ErrNilSelector
+	}
+	return seqOf(func() Enumerator[Result] { return SelectMust(FromSeq(seq), selector) }), nil
+}
+
+// SelectSeqMust is like SelectSeq but panics in case of error.
+func SelectSeqMust[Source, Result any](seq iter.Seq[Source], selector func(Source) Result) iter.Seq[Result] {
+	r, err := SelectSeq(seq, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// OrderByLsSeq is like OrderByLs but works with iter.Seq.
+func OrderByLsSeq[Source, Key any](seq iter.Seq[Source],
+	keySelector func(Source) Key, lesser Lesser[Key]) (iter.Seq[Source], error) {
+	if seq == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if lesser == nil {
+		return nil, ErrNilLesser
+	}
+	return seqOf(func() Enumerator[Source] {
+		return OrderByLsMust(FromSeq(seq), keySelector, lesser).GetEnumerator()
+	}), nil
+}
+
+// OrderByLsSeqMust is like OrderByLsSeq but panics in case of error.
+func OrderByLsSeqMust[Source, Key any](seq iter.Seq[Source],
+	keySelector func(Source) Key, lesser Lesser[Key]) iter.Seq[Source] {
+	r, err := OrderByLsSeq(seq, keySelector, lesser)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,125 @@
+//go:build go1.23
+
+package go2linq
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestFromSeq_int(t *testing.T) {
+	tests := []struct {
+		name string
+		seq  func(func(int) bool)
+		want Enumerator[int]
+	}{
+		{name: "NilSeq",
+			seq:  nil,
+			want: Empty[int](),
+		},
+		{name: "Values",
+			seq:  slices.Values([]int{1, 2, 3, 4}),
+			want: NewOnSlice(1, 2, 3, 4),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromSeq(tt.seq)
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("FromSeq() = '%v', want '%v'", String(got), String(tt.want))
+			}
+		})
+	}
+}
+
+func TestFromSeq_Reset_Close(t *testing.T) {
+	stopped := 0
+	seq := func(yield func(int) bool) {
+		defer func() { stopped++ }()
+		for i := 1; i <= 4; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	en := FromSeq(seq)
+	if got := FirstMust(en); got != 1 {
+		t.Errorf("First(FromSeq()) = %v, want %v", got, 1)
+	}
+	if stopped != 1 {
+		t.Errorf("seq was stopped %v times, want %v", stopped, 1)
+	}
+	en.Reset()
+	if got, want := Slice(en), []int{1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Slice(FromSeq()) after Reset = '%v', want '%v'", got, want)
+	}
+	if stopped != 2 {
+		t.Errorf("seq was stopped %v times, want %v", stopped, 2)
+	}
+}
+
+func TestFromSeq2_ToSeq2(t *testing.T) {
+	m := map[int]string{1: "one", 2: "two", 3: "three"}
+	got := make(map[int]string)
+	for k, v := range ToSeq2(FromSeq2(maps.All(m))) {
+		got[k] = v
+	}
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("ToSeq2(FromSeq2()) = '%v', want '%v'", got, m)
+	}
+}
+
+func TestFromSeqIdx_ToSeqIdx(t *testing.T) {
+	want := []string{"one", "two", "three"}
+	var got []string
+	for i, s := range ToSeqIdx(FromSeqIdx(slices.All(want))) {
+		if want[i] != s {
+			t.Errorf("ToSeqIdx() index %v = '%v', want '%v'", i, s, want[i])
+		}
+		got = append(got, s)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSeqIdx(FromSeqIdx()) = '%v', want '%v'", got, want)
+	}
+}
+
+func TestToSeq_break(t *testing.T) {
+	cc := newCloseCounter(1, 2, 3, 4)
+	var got []int
+	for i := range ToSeq[int](cc) {
+		if i > 2 {
+			break
+		}
+		got = append(got, i)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSeq() = '%v', want '%v'", got, want)
+	}
+	if cc.closed != 1 {
+		t.Errorf("source was closed %v times, want %v", cc.closed, 1)
+	}
+}
+
+func TestWhereSeq_SelectSeq_OrderByLsSeq(t *testing.T) {
+	seq := slices.Values([]int{5, 2, 8, 1, 9, 4})
+	even := WhereSeqMust(seq, func(i int) bool { return i%2 == 0 })
+	sorted := OrderByLsSeqMust(even, Identity[int], Lesser[int](Order[int]{}))
+	strs := SelectSeqMust(sorted, func(i int) string { return string(rune('a' + i)) })
+	want := []string{"c", "e", "i"}
+	// the resulting seq may be iterated more than once
+	for range 2 {
+		if got := slices.Collect(strs); !reflect.DeepEqual(got, want) {
+			t.Errorf("SelectSeq(OrderByLsSeq(WhereSeq())) = '%v', want '%v'", got, want)
+		}
+	}
+	if _, err := WhereSeq[int](nil, func(int) bool { return true }); err != ErrNilSource {
+		t.Errorf("WhereSeq() error = '%v', want '%v'", err, ErrNilSource)
+	}
+	if _, err := SelectSeq[int, int](seq, nil); err != ErrNilSelector {
+		t.Errorf("SelectSeq() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+}
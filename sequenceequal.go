@@ -32,6 +32,9 @@ func SequenceEqualSelf[Source any](first, second Enumerator[Source]) (bool, erro
 		return false, ErrNilSource
 	}
 	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
 	first.Reset()
 	return SequenceEqual(first, NewOnSliceEn(sl2...))
 }
@@ -57,15 +60,28 @@ func SequenceEqualEq[Source any](first, second Enumerator[Source], equaler Equal
 	}
 	for first.MoveNext() {
 		if !second.MoveNext() {
+			if err := Err(second); err != nil {
+				return false, err
+			}
+			Close(first)
 			return false, nil
 		}
 		if !equaler.Equal(first.Current(), second.Current()) {
+			Close(first)
+			Close(second)
 			return false, nil
 		}
 	}
+	if err := firstErr(Err(first), Err(second)); err != nil {
+		return false, err
+	}
 	if second.MoveNext() {
+		Close(second)
 		return false, nil
 	}
+	if err := Err(second); err != nil {
+		return false, err
+	}
 	return true, nil
 }
 
@@ -87,6 +103,9 @@ func SequenceEqualEqSelf[Source any](first, second Enumerator[Source], equaler E
 		return false, ErrNilSource
 	}
 	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
 	first.Reset()
 	return SequenceEqualEq(first, NewOnSliceEn(sl2...), equaler)
 }
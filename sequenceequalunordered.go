@@ -0,0 +1,152 @@
+//go:build go1.18
+
+package go2linq
+
+// https://docs.microsoft.com/dotnet/api/system.collections.generic.iset-1
+
+// SequenceEqualUnordered determines whether two sequences contain the same elements regardless of their order using reflect.DeepEqual to compare elements.
+// Duplicates are taken into account: each element must occur in both sequences the same number of times
+// (i.e. the sequences are equal as multisets, see also SetEquals).
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use SequenceEqualUnorderedSelf instead.
+func SequenceEqualUnordered[Source any](first, second Enumerator[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	return equalCounts(first, second, lookupEqFactory[Source](nil))
+}
+
+// SequenceEqualUnorderedMust is like SequenceEqualUnordered but panics in case of error.
+func SequenceEqualUnorderedMust[Source any](first, second Enumerator[Source]) bool {
+	r, err := SequenceEqualUnordered(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SequenceEqualUnorderedSelf determines whether two sequences contain the same elements regardless of their order using reflect.DeepEqual to compare elements.
+// (See SequenceEqualUnordered function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func SequenceEqualUnorderedSelf[Source any](first, second Enumerator[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return SequenceEqualUnordered(first, NewOnSliceEn(sl2...))
+}
+
+// SequenceEqualUnorderedSelfMust is like SequenceEqualUnorderedSelf but panics in case of error.
+func SequenceEqualUnorderedSelfMust[Source any](first, second Enumerator[Source]) bool {
+	r, err := SequenceEqualUnorderedSelf(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SequenceEqualUnorderedEq determines whether two sequences contain the same elements regardless of their order using a specified Equaler to compare elements.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See SequenceEqualUnordered function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use SequenceEqualUnorderedEqSelf instead.
+func SequenceEqualUnorderedEq[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	return equalCounts(first, second, lookupEqFactory(equaler))
+}
+
+// SequenceEqualUnorderedEqMust is like SequenceEqualUnorderedEq but panics in case of error.
+func SequenceEqualUnorderedEqMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) bool {
+	r, err := SequenceEqualUnorderedEq(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SequenceEqualUnorderedEqSelf determines whether two sequences contain the same elements regardless of their order using a specified Equaler to compare elements.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See SequenceEqualUnordered function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func SequenceEqualUnorderedEqSelf[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return SequenceEqualUnorderedEq(first, NewOnSliceEn(sl2...), equaler)
+}
+
+// SequenceEqualUnorderedEqSelfMust is like SequenceEqualUnorderedEqSelf but panics in case of error.
+func SequenceEqualUnorderedEqSelfMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) bool {
+	r, err := SequenceEqualUnorderedEqSelf(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SequenceEqualUnorderedCmp determines whether two sequences contain the same elements regardless of their order using a specified Comparer to compare elements.
+// (See SequenceEqualUnordered function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use SequenceEqualUnorderedCmpSelf instead.
+func SequenceEqualUnorderedCmp[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	if comparer == nil {
+		return false, ErrNilComparer
+	}
+	return equalCounts(first, second, lookupCmpFactory(comparer))
+}
+
+// SequenceEqualUnorderedCmpMust is like SequenceEqualUnorderedCmp but panics in case of error.
+func SequenceEqualUnorderedCmpMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) bool {
+	r, err := SequenceEqualUnorderedCmp(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SequenceEqualUnorderedCmpSelf determines whether two sequences contain the same elements regardless of their order using a specified Comparer to compare elements.
+// (See SequenceEqualUnordered function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func SequenceEqualUnorderedCmpSelf[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	if comparer == nil {
+		return false, ErrNilComparer
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return SequenceEqualUnorderedCmp(first, NewOnSliceEn(sl2...), comparer)
+}
+
+// SequenceEqualUnorderedCmpSelfMust is like SequenceEqualUnorderedCmpSelf but panics in case of error.
+func SequenceEqualUnorderedCmpSelfMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) bool {
+	r, err := SequenceEqualUnorderedCmpSelf(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
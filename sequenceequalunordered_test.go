@@ -0,0 +1,38 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_SequenceEqualUnorderedMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  Enumerator[int]
+		second Enumerator[int]
+		want   bool
+	}{
+		{name: "BothEmpty", first: Empty[int](), second: Empty[int](), want: true},
+		{name: "Permutation", first: NewOnSlice(1, 2, 2, 3), second: NewOnSlice(2, 3, 1, 2), want: true},
+		{name: "DifferentCounts", first: NewOnSlice(1, 1, 2), second: NewOnSlice(1, 2, 2), want: false},
+		{name: "FirstLonger", first: NewOnSlice(1, 2, 2), second: NewOnSlice(1, 2), want: false},
+		{name: "SecondLonger", first: NewOnSlice(1, 2), second: NewOnSlice(1, 2, 2), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SequenceEqualUnorderedMust(tt.first, tt.second); got != tt.want {
+				t.Errorf("SequenceEqualUnordered() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_SequenceEqualUnorderedEqMust(t *testing.T) {
+	if got := SequenceEqualUnorderedEqMust(NewOnSliceEn("a", "B", "b"), NewOnSliceEn("b", "A", "b"), CaseInsensitiveEqualer); !got {
+		t.Errorf("SequenceEqualUnorderedEq() = %v, want true", got)
+	}
+	if got := SequenceEqualUnorderedCmpMust(NewOnSliceEn("a", "B", "b"), NewOnSliceEn("b", "A", "a"), CaseInsensitiveComparer); got {
+		t.Errorf("SequenceEqualUnorderedCmp() = %v, want false", got)
+	}
+}
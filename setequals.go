@@ -0,0 +1,163 @@
+//go:build go1.18
+
+package go2linq
+
+// https://docs.microsoft.com/dotnet/api/system.collections.generic.iset-1
+
+// SetEquals determines whether two sequences contain the same set of elements using reflect.DeepEqual to compare elements.
+// Order and duplicates of elements are ignored.
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use SetEqualsSelf instead.
+func SetEquals[Source any](first, second Enumerator[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	r, err := relateSets(first, second, lookupEqFactory[Source](nil), false)
+	if err != nil {
+		return false, err
+	}
+	return !r.firstOnly && !r.secondOnly, nil
+}
+
+// SetEqualsMust is like SetEquals but panics in case of error.
+func SetEqualsMust[Source any](first, second Enumerator[Source]) bool {
+	r, err := SetEquals(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SetEqualsSelf determines whether two sequences contain the same set of elements using reflect.DeepEqual to compare elements.
+// (See SetEquals function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func SetEqualsSelf[Source any](first, second Enumerator[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return SetEquals(first, NewOnSliceEn(sl2...))
+}
+
+// SetEqualsSelfMust is like SetEqualsSelf but panics in case of error.
+func SetEqualsSelfMust[Source any](first, second Enumerator[Source]) bool {
+	r, err := SetEqualsSelf(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SetEqualsEq determines whether two sequences contain the same set of elements using a specified Equaler to compare elements.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See SetEquals function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use SetEqualsEqSelf instead.
+func SetEqualsEq[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	r, err := relateSets(first, second, lookupEqFactory(equaler), false)
+	if err != nil {
+		return false, err
+	}
+	return !r.firstOnly && !r.secondOnly, nil
+}
+
+// SetEqualsEqMust is like SetEqualsEq but panics in case of error.
+func SetEqualsEqMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) bool {
+	r, err := SetEqualsEq(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SetEqualsEqSelf determines whether two sequences contain the same set of elements using a specified Equaler to compare elements.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See SetEquals function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func SetEqualsEqSelf[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return SetEqualsEq(first, NewOnSliceEn(sl2...), equaler)
+}
+
+// SetEqualsEqSelfMust is like SetEqualsEqSelf but panics in case of error.
+func SetEqualsEqSelfMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) bool {
+	r, err := SetEqualsEqSelf(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SetEqualsCmp determines whether two sequences contain the same set of elements using a specified Comparer to compare elements.
+// (See SetEquals function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use SetEqualsCmpSelf instead.
+func SetEqualsCmp[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	if comparer == nil {
+		return false, ErrNilComparer
+	}
+	r, err := relateSets(first, second, lookupCmpFactory(comparer), false)
+	if err != nil {
+		return false, err
+	}
+	return !r.firstOnly && !r.secondOnly, nil
+}
+
+// SetEqualsCmpMust is like SetEqualsCmp but panics in case of error.
+func SetEqualsCmpMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) bool {
+	r, err := SetEqualsCmp(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// SetEqualsCmpSelf determines whether two sequences contain the same set of elements using a specified Comparer to compare elements.
+// (See SetEquals function.)
+// 'second' is enumerated first (and entirely).
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func SetEqualsCmpSelf[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (bool, error) {
+	if first == nil || second == nil {
+		return false, ErrNilSource
+	}
+	if comparer == nil {
+		return false, ErrNilComparer
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	first.Reset()
+	return SetEqualsCmp(first, NewOnSliceEn(sl2...), comparer)
+}
+
+// SetEqualsCmpSelfMust is like SetEqualsCmpSelf but panics in case of error.
+func SetEqualsCmpSelfMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) bool {
+	r, err := SetEqualsCmpSelf(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
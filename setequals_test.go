@@ -0,0 +1,53 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_SetEqualsMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  Enumerator[int]
+		second Enumerator[int]
+		want   bool
+	}{
+		{name: "BothEmpty", first: Empty[int](), second: Empty[int](), want: true},
+		{name: "SameOrderIgnored", first: NewOnSlice(1, 2, 3), second: NewOnSlice(3, 1, 2), want: true},
+		{name: "DuplicatesIgnored", first: NewOnSlice(1, 1, 2), second: NewOnSlice(2, 1), want: true},
+		{name: "FirstHasMore", first: NewOnSlice(1, 2, 3), second: NewOnSlice(1, 2), want: false},
+		{name: "SecondHasMore", first: NewOnSlice(1), second: NewOnSlice(1, 2), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetEqualsMust(tt.first, tt.second); got != tt.want {
+				t.Errorf("SetEquals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_SetEquals_variants(t *testing.T) {
+	first := []string{"a", "B", "b"}
+	second := []string{"A", "b"}
+	if got := SetEqualsEqMust(NewOnSliceEn(first...), NewOnSliceEn(second...), CaseInsensitiveEqualer); !got {
+		t.Errorf("SetEqualsEq() = %v, want true", got)
+	}
+	if got := SetEqualsCmpMust(NewOnSliceEn(first...), NewOnSliceEn(second...), CaseInsensitiveComparer); !got {
+		t.Errorf("SetEqualsCmp() = %v, want true", got)
+	}
+	if got := SetEqualsMust(NewOnSliceEn(first...), NewOnSliceEn(second...)); got {
+		t.Errorf("SetEquals() = %v, want false", got)
+	}
+	e := NewOnSliceEn(1, 2, 1)
+	if got := SetEqualsSelfMust(e, e); !got {
+		t.Errorf("SetEqualsSelf() = %v, want true", got)
+	}
+	if _, err := SetEqualsCmp(NewOnSliceEn(1), NewOnSliceEn(1), nil); err != ErrNilComparer {
+		t.Errorf("SetEqualsCmp() error = '%v', want '%v'", err, ErrNilComparer)
+	}
+	if _, err := SetEquals(NewOnSliceEn(1, 2), failAt(NewOnSliceEn(1, 2), 2)); err != errTest {
+		t.Errorf("SetEquals() error = '%v', want '%v'", err, errTest)
+	}
+}
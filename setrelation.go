@@ -0,0 +1,82 @@
+//go:build go1.18
+
+package go2linq
+
+// https://docs.microsoft.com/dotnet/api/system.collections.generic.iset-1
+
+// setRelation describes how the sets of the elements of two sequences relate
+type setRelation struct {
+	// firstOnly - 'first' has an element not contained in 'second'
+	firstOnly bool
+	// secondOnly - 'second' has an element not contained in 'first'
+	secondOnly bool
+	// common - the sequences have a common element
+	common bool
+}
+
+// relateSets computes the setRelation of two sequences.
+// If 'overlapsOnly' is true, the enumeration stops on the first common element
+// (so only setRelation.common is valid).
+func relateSets[Source any](first, second Enumerator[Source], newLookup func() *Lookup[Source, int],
+	overlapsOnly bool) (setRelation, error) {
+	var r setRelation
+	bc := &bagCounter[Source]{lk: newLookup()}
+	for second.MoveNext() {
+		bc.add(second.Current())
+	}
+	if err := Err(second); err != nil {
+		return r, err
+	}
+	// seen[i] - the key of bc.counts[i] is contained in 'first'
+	seen := make([]bool, len(bc.counts))
+	nseen := 0
+	for first.MoveNext() {
+		i := bc.index(first.Current())
+		if i < 0 {
+			r.firstOnly = true
+			continue
+		}
+		r.common = true
+		if overlapsOnly {
+			Close(first)
+			return r, nil
+		}
+		if !seen[i] {
+			seen[i] = true
+			nseen++
+		}
+	}
+	if err := Err(first); err != nil {
+		return setRelation{}, err
+	}
+	r.secondOnly = nseen < len(bc.counts)
+	return r, nil
+}
+
+// equalCounts determines whether two sequences contain the same elements the same number of times
+func equalCounts[Source any](first, second Enumerator[Source], newLookup func() *Lookup[Source, int]) (bool, error) {
+	bc := &bagCounter[Source]{lk: newLookup()}
+	for second.MoveNext() {
+		bc.add(second.Current())
+	}
+	if err := Err(second); err != nil {
+		return false, err
+	}
+	n := 0
+	for _, c := range bc.counts {
+		n += c
+	}
+	for first.MoveNext() {
+		i := bc.index(first.Current())
+		if i < 0 || bc.counts[i] == 0 {
+			Close(first)
+			return false, nil
+		}
+		bc.counts[i]--
+		n--
+	}
+	if err := Err(first); err != nil {
+		return false, err
+	}
+	return n == 0, nil
+}
@@ -0,0 +1,360 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"constraints"
+	"math"
+	"sort"
+)
+
+// https://en.wikipedia.org/wiki/Algorithms_for_calculating_variance#Welford's_online_algorithm
+// https://en.wikipedia.org/wiki/Percentile
+// https://numpy.org/doc/stable/reference/generated/numpy.percentile.html
+
+// welford computes the count, the mean and the sum of squared deviations from the mean
+// of the values obtained by invoking 'selector' on each element of 'source'
+func welford[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	selector func(Source) Result) (n int, mean, m2 float64) {
+	for source.MoveNext() {
+		x := float64(selector(source.Current()))
+		n++
+		d := x - mean
+		mean += d / float64(n)
+		m2 += d * (x - mean)
+	}
+	return n, mean, m2
+}
+
+// variance computes the population (if 'sample' is false) or the sample variance
+func variance[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	selector func(Source) Result, sample bool) (float64, error) {
+	if source == nil {
+		return 0, ErrNilSource
+	}
+	if selector == nil {
+		return 0, ErrNilSelector
+	}
+	n, _, m2 := welford(source, selector)
+	if err := Err(source); err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, ErrEmptySource
+	}
+	if !sample {
+		return m2 / float64(n), nil
+	}
+	if n == 1 {
+		return math.NaN(), nil
+	}
+	return m2 / float64(n-1), nil
+}
+
+// Variance computes the population variance of a sequence of values that are obtained
+// by invoking a transform function on each element of the input sequence.
+// Welford's algorithm is used, so the values are processed in a single pass in a numerically stable way.
+func Variance[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source], selector func(Source) Result) (float64, error) {
+	return variance(source, selector, false)
+}
+
+// VarianceMust is like Variance but panics in case of error.
+func VarianceMust[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source], selector func(Source) Result) float64 {
+	r, err := Variance(source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// VarianceSample computes the sample (unbiased, with Bessel's correction) variance of a sequence of values
+// that are obtained by invoking a transform function on each element of the input sequence.
+// If the sequence contains a single element, NaN is returned.
+func VarianceSample[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source], selector func(Source) Result) (float64, error) {
+	return variance(source, selector, true)
+}
+
+// VarianceSampleMust is like VarianceSample but panics in case of error.
+func VarianceSampleMust[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source], selector func(Source) Result) float64 {
+	r, err := VarianceSample(source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// StdDev computes the population standard deviation (the square root of Variance) of a sequence of values
+// that are obtained by invoking a transform function on each element of the input sequence.
+func StdDev[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source], selector func(Source) Result) (float64, error) {
+	v, err := variance(source, selector, false)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(v), nil
+}
+
+// StdDevMust is like StdDev but panics in case of error.
+func StdDevMust[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source], selector func(Source) Result) float64 {
+	r, err := StdDev(source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// StdDevSample computes the sample standard deviation (the square root of VarianceSample) of a sequence of values
+// that are obtained by invoking a transform function on each element of the input sequence.
+func StdDevSample[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source], selector func(Source) Result) (float64, error) {
+	v, err := variance(source, selector, true)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(v), nil
+}
+
+// StdDevSampleMust is like StdDevSample but panics in case of error.
+func StdDevSampleMust[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source], selector func(Source) Result) float64 {
+	r, err := StdDevSample(source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Interpolation specifies how Percentile computes the value
+// when the percentile lies between two data points.
+type Interpolation int
+
+const (
+	// InterpolationLinear interpolates linearly between the two data points.
+	InterpolationLinear Interpolation = iota
+	// InterpolationLower returns the lower data point.
+	InterpolationLower
+	// InterpolationHigher returns the higher data point.
+	InterpolationHigher
+	// InterpolationNearest returns the nearest data point (the one with the even index in case of tie).
+	InterpolationNearest
+	// InterpolationMidpoint returns the average of the two data points.
+	InterpolationMidpoint
+)
+
+// sortedValues returns the sorted values obtained by invoking 'selector' on each element of 'source'
+func sortedValues[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	selector func(Source) Result) ([]float64, error) {
+	var sl []float64
+	for source.MoveNext() {
+		sl = append(sl, float64(selector(source.Current())))
+	}
+	if err := Err(source); err != nil {
+		return nil, err
+	}
+	if len(sl) == 0 {
+		return nil, ErrEmptySource
+	}
+	sort.Float64s(sl)
+	return sl, nil
+}
+
+// percentileSorted returns the 'q'-th quantile (0 <= q <= 1) of the non-empty sorted 'sl'
+func percentileSorted(sl []float64, q float64, interpolation Interpolation) float64 {
+	pos := q * float64(len(sl)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	switch interpolation {
+	case InterpolationLower:
+		return sl[lo]
+	case InterpolationHigher:
+		return sl[hi]
+	case InterpolationNearest:
+		return sl[int(math.RoundToEven(pos))]
+	case InterpolationMidpoint:
+		return (sl[lo] + sl[hi]) / 2
+	}
+	return sl[lo] + (pos-float64(lo))*(sl[hi]-sl[lo])
+}
+
+// Median computes the median of a sequence of values that are obtained
+// by invoking a transform function on each element of the input sequence.
+// If the sequence has an even number of elements, the average of the two middle values is returned.
+// The values are buffered and sorted, so Median takes O(n) memory.
+func Median[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source], selector func(Source) Result) (float64, error) {
+	if source == nil {
+		return 0, ErrNilSource
+	}
+	if selector == nil {
+		return 0, ErrNilSelector
+	}
+	sl, err := sortedValues(source, selector)
+	if err != nil {
+		return 0, err
+	}
+	return percentileSorted(sl, 0.5, InterpolationLinear), nil
+}
+
+// MedianMust is like Median but panics in case of error.
+func MedianMust[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source], selector func(Source) Result) float64 {
+	r, err := Median(source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Percentile computes the 'p'-th percentile (0 <= p <= 100) of a sequence of values that are obtained
+// by invoking a transform function on each element of the input sequence.
+// 'interpolation' specifies the value returned when the percentile lies between two data points
+// (the same way as the 'method' parameter of numpy.percentile does).
+// The values are buffered and sorted, so Percentile takes O(n) memory (see Quantiles for the streaming alternative).
+func Percentile[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	selector func(Source) Result, p float64, interpolation Interpolation) (float64, error) {
+	if source == nil {
+		return 0, ErrNilSource
+	}
+	if selector == nil {
+		return 0, ErrNilSelector
+	}
+	if !(0 <= p && p <= 100) {
+		return 0, ErrQuantileOutOfRange
+	}
+	if interpolation < InterpolationLinear || interpolation > InterpolationMidpoint {
+		return 0, ErrInvalidInterpolation
+	}
+	sl, err := sortedValues(source, selector)
+	if err != nil {
+		return 0, err
+	}
+	return percentileSorted(sl, p/100, interpolation), nil
+}
+
+// PercentileMust is like Percentile but panics in case of error.
+func PercentileMust[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	selector func(Source) Result, p float64, interpolation Interpolation) float64 {
+	r, err := Percentile(source, selector, p, interpolation)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Mode returns the most frequent of the values that are obtained
+// by invoking a transform function on each element of the input sequence.
+// If several values are the most frequent, the one that occurs first in the sequence is returned.
+func Mode[Source any, Result comparable](source Enumerator[Source], selector func(Source) Result) (Result, error) {
+	var r0 Result
+	if source == nil {
+		return r0, ErrNilSource
+	}
+	if selector == nil {
+		return r0, ErrNilSelector
+	}
+	counts := make(map[Result]int)
+	var mode Result
+	best := 0
+	for source.MoveNext() {
+		r := selector(source.Current())
+		c := counts[r] + 1
+		counts[r] = c
+		if c > best {
+			mode, best = r, c
+		}
+	}
+	if err := Err(source); err != nil {
+		return r0, err
+	}
+	if best == 0 {
+		return r0, ErrEmptySource
+	}
+	return mode, nil
+}
+
+// ModeMust is like Mode but panics in case of error.
+func ModeMust[Source any, Result comparable](source Enumerator[Source], selector func(Source) Result) Result {
+	r, err := Mode(source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// HistogramBucket is a bucket of a histogram (see Histogram).
+type HistogramBucket struct {
+	// Low and High are the bounds of the bucket.
+	// The bucket contains the values v such that Low <= v < High
+	// (Low <= v <= High for the last bucket).
+	Low, High float64
+	// Count is the number of values in the bucket.
+	Count int
+}
+
+// Histogram distributes the values that are obtained by invoking a transform function
+// on each element of the input sequence into 'buckets' buckets of equal width
+// spanning the range from the minimum to the maximum value.
+// If all the values are equal, they are all put into the first bucket.
+// If any value is NaN or infinite, ErrNotFinite is returned.
+// The values are buffered, so Histogram takes O(n) memory.
+func Histogram[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	selector func(Source) Result, buckets int) ([]HistogramBucket, error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if selector == nil {
+		return nil, ErrNilSelector
+	}
+	if buckets <= 0 {
+		return nil, ErrSizeOutOfRange
+	}
+	var vv []float64
+	lo, hi := math.MaxFloat64, -math.MaxFloat64
+	for source.MoveNext() {
+		v := float64(selector(source.Current()))
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			Close(source)
+			return nil, ErrNotFinite
+		}
+		vv = append(vv, v)
+		if v < lo {
+			lo = v
+		}
+		if v > hi {
+			hi = v
+		}
+	}
+	if err := Err(source); err != nil {
+		return nil, err
+	}
+	if len(vv) == 0 {
+		return nil, ErrEmptySource
+	}
+	// the values are scaled down before subtraction, so that hi-lo does not overflow
+	nb := float64(buckets)
+	width := hi/nb - lo/nb
+	r := make([]HistogramBucket, buckets)
+	for i := range r {
+		r[i].Low = lo + float64(i)*width
+		r[i].High = lo + float64(i+1)*width
+	}
+	r[buckets-1].High = hi
+	for _, v := range vv {
+		i := 0
+		if width > 0 {
+			i = int((v/nb - lo/nb) / width * nb)
+			if i < 0 {
+				i = 0
+			} else if i >= buckets {
+				i = buckets - 1
+			}
+		}
+		r[i].Count++
+	}
+	return r, nil
+}
+
+// HistogramMust is like Histogram but panics in case of error.
+func HistogramMust[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	selector func(Source) Result, buckets int) []HistogramBucket {
+	r, err := Histogram(source, selector, buckets)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,199 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func Test_Variance_StdDev(t *testing.T) {
+	tests := []struct {
+		name       string
+		source     []float64
+		wantPop    float64
+		wantSample float64
+	}{
+		{name: "SingleElement", source: []float64{5}, wantPop: 0, wantSample: math.NaN()},
+		{name: "Simple", source: []float64{2, 4, 4, 4, 5, 5, 7, 9}, wantPop: 4, wantSample: 32.0 / 7},
+		// large offset: the naive sum of squares loses all the precision here
+		{name: "LargeOffset", source: []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}, wantPop: 22.5, wantSample: 30},
+	}
+	equal := func(x, y float64) bool {
+		return (math.IsNaN(x) && math.IsNaN(y)) || math.Abs(x-y) < 1e-9
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VarianceMust(NewOnSliceEn(tt.source...), Identity[float64]); !equal(got, tt.wantPop) {
+				t.Errorf("Variance() = %v, want %v", got, tt.wantPop)
+			}
+			if got := VarianceSampleMust(NewOnSliceEn(tt.source...), Identity[float64]); !equal(got, tt.wantSample) {
+				t.Errorf("VarianceSample() = %v, want %v", got, tt.wantSample)
+			}
+			if got := StdDevMust(NewOnSliceEn(tt.source...), Identity[float64]); !equal(got, math.Sqrt(tt.wantPop)) {
+				t.Errorf("StdDev() = %v, want %v", got, math.Sqrt(tt.wantPop))
+			}
+			if got := StdDevSampleMust(NewOnSliceEn(tt.source...), Identity[float64]); !equal(got, math.Sqrt(tt.wantSample)) {
+				t.Errorf("StdDevSample() = %v, want %v", got, math.Sqrt(tt.wantSample))
+			}
+		})
+	}
+}
+
+func Test_Statistics_errors(t *testing.T) {
+	if _, err := Variance(Empty[int](), Identity[int]); err != ErrEmptySource {
+		t.Errorf("Variance() error = '%v', want '%v'", err, ErrEmptySource)
+	}
+	if _, err := StdDevSample(Empty[int](), Identity[int]); err != ErrEmptySource {
+		t.Errorf("StdDevSample() error = '%v', want '%v'", err, ErrEmptySource)
+	}
+	if _, err := Median(Empty[int](), Identity[int]); err != ErrEmptySource {
+		t.Errorf("Median() error = '%v', want '%v'", err, ErrEmptySource)
+	}
+	if _, err := Percentile(Empty[int](), Identity[int], 50, InterpolationLinear); err != ErrEmptySource {
+		t.Errorf("Percentile() error = '%v', want '%v'", err, ErrEmptySource)
+	}
+	if _, err := Mode(Empty[int](), Identity[int]); err != ErrEmptySource {
+		t.Errorf("Mode() error = '%v', want '%v'", err, ErrEmptySource)
+	}
+	if _, err := Histogram(Empty[int](), Identity[int], 3); err != ErrEmptySource {
+		t.Errorf("Histogram() error = '%v', want '%v'", err, ErrEmptySource)
+	}
+	if _, err := Variance[int, int](NewOnSliceEn(1), nil); err != ErrNilSelector {
+		t.Errorf("Variance() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+	if _, err := Percentile(NewOnSliceEn(1), Identity[int], 101, InterpolationLinear); err != ErrQuantileOutOfRange {
+		t.Errorf("Percentile() error = '%v', want '%v'", err, ErrQuantileOutOfRange)
+	}
+	if _, err := Percentile(NewOnSliceEn(1), Identity[int], 50, Interpolation(42)); err != ErrInvalidInterpolation {
+		t.Errorf("Percentile() error = '%v', want '%v'", err, ErrInvalidInterpolation)
+	}
+	if _, err := Histogram(NewOnSliceEn(1), Identity[int], 0); err != ErrSizeOutOfRange {
+		t.Errorf("Histogram() error = '%v', want '%v'", err, ErrSizeOutOfRange)
+	}
+	if _, err := Median(failAt(NewOnSliceEn(1, 2, 3), 2), Identity[int]); err != errTest {
+		t.Errorf("Median() error = '%v', want '%v'", err, errTest)
+	}
+}
+
+func Test_MedianMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		source Enumerator[int]
+		want   float64
+	}{
+		{name: "SingleElement", source: NewOnSlice(7), want: 7},
+		{name: "Odd", source: NewOnSlice(5, 1, 3), want: 3},
+		{name: "Even", source: NewOnSlice(4, 1, 3, 2), want: 2.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MedianMust(tt.source, Identity[int]); got != tt.want {
+				t.Errorf("Median() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_PercentileMust(t *testing.T) {
+	// the expected values are the same as numpy.percentile([1, 2, 3, 4], 40, method=...) returns
+	tests := []struct {
+		name          string
+		interpolation Interpolation
+		p             float64
+		want          float64
+	}{
+		{name: "Linear", interpolation: InterpolationLinear, p: 40, want: 2.2},
+		{name: "Lower", interpolation: InterpolationLower, p: 40, want: 2},
+		{name: "Higher", interpolation: InterpolationHigher, p: 40, want: 3},
+		{name: "Nearest", interpolation: InterpolationNearest, p: 40, want: 2},
+		{name: "NearestTie", interpolation: InterpolationNearest, p: 50, want: 3},
+		{name: "Midpoint", interpolation: InterpolationMidpoint, p: 40, want: 2.5},
+		{name: "Min", interpolation: InterpolationLinear, p: 0, want: 1},
+		{name: "Max", interpolation: InterpolationLinear, p: 100, want: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PercentileMust(NewOnSliceEn(4, 2, 1, 3), Identity[int], tt.p, tt.interpolation)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Percentile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ModeMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		source Enumerator[string]
+		want   int
+	}{
+		{name: "SingleElement", source: NewOnSlice("a"), want: 1},
+		{name: "Simple", source: NewOnSlice("a", "bb", "cc", "d", "ee"), want: 2},
+		{name: "TieFirstWins", source: NewOnSlice("aaa", "b", "ccc", "d"), want: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ModeMust(tt.source, func(s string) int { return len(s) }); got != tt.want {
+				t.Errorf("Mode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_HistogramMust(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  Enumerator[int]
+		buckets int
+		want    []HistogramBucket
+	}{
+		{name: "Simple",
+			source:  NewOnSlice(0, 1, 2, 3, 4, 5, 6, 9),
+			buckets: 3,
+			want:    []HistogramBucket{{Low: 0, High: 3, Count: 3}, {Low: 3, High: 6, Count: 3}, {Low: 6, High: 9, Count: 2}},
+		},
+		{name: "EqualValues",
+			source:  NewOnSlice(2, 2, 2),
+			buckets: 2,
+			want:    []HistogramBucket{{Low: 2, High: 2, Count: 3}, {Low: 2, High: 2, Count: 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HistogramMust(tt.source, Identity[int], tt.buckets); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Histogram() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Histogram_nonFinite(t *testing.T) {
+	tests := []struct {
+		name   string
+		source []float64
+	}{
+		{name: "PlusInf", source: []float64{0, math.Inf(1)}},
+		{name: "MinusInf", source: []float64{math.Inf(-1), 1}},
+		{name: "NaN", source: []float64{1, math.NaN(), 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Histogram(NewOnSliceEn(tt.source...), Identity[float64], 2); err != ErrNotFinite {
+				t.Errorf("Histogram() error = '%v', want '%v'", err, ErrNotFinite)
+			}
+		})
+	}
+}
+
+func Test_Histogram_wideRange(t *testing.T) {
+	// hi-lo overflows float64
+	got := HistogramMust(NewOnSliceEn(-math.MaxFloat64, 0, math.MaxFloat64, math.MaxFloat64), Identity[float64], 2)
+	if len(got) != 2 || got[0].Count != 1 || got[1].Count != 3 {
+		t.Errorf("Histogram() = %v, want counts 1 and 3", got)
+	}
+	if got[0].Low != -math.MaxFloat64 || got[1].High != math.MaxFloat64 || got[0].High != 0 {
+		t.Errorf("Histogram() bounds = %v", got)
+	}
+}
@@ -0,0 +1,150 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// https://docs.microsoft.com/dotnet/api/system.stringcomparer
+
+// foldRune returns the canonical rune of the case folding orbit of 'r' (see unicode.SimpleFold),
+// i.e. the least rune equivalent to 'r' under Unicode simple case folding
+func foldRune(r rune) rune {
+	if r < utf8.RuneSelf {
+		if 'a' <= r && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		return r
+	}
+	m := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < m {
+			m = f
+		}
+	}
+	return m
+}
+
+// compareFold compares the strings under Unicode simple case folding
+func compareFold(x, y string) int {
+	return compareMapped(x, y, foldRune)
+}
+
+var (
+	// OrdinalIgnoreCaseEqualer is an Equaler for string that uses Unicode simple case folding
+	// (the same equivalence as strings.EqualFold).
+	// Unlike CaseInsensitiveEqualer, it does not allocate.
+	OrdinalIgnoreCaseEqualer Equaler[string] = EqualerFunc[string](func(x, y string) bool {
+		return compareFold(x, y) == 0
+	})
+
+	// OrdinalIgnoreCaseHasher is a Hasher for string that uses Unicode simple case folding.
+	OrdinalIgnoreCaseHasher Hasher[string] = NewHasher(
+		func(x string) uint64 { return hashMappedString(x, foldRune) },
+		OrdinalIgnoreCaseEqualer.Equal,
+	)
+
+	// OrdinalIgnoreCaseLesser is a Lesser for string that uses Unicode simple case folding.
+	OrdinalIgnoreCaseLesser Lesser[string] = LesserFunc[string](func(x, y string) bool {
+		return compareFold(x, y) < 0
+	})
+
+	// OrdinalIgnoreCaseComparer is a Comparer for string that uses Unicode simple case folding.
+	// The case folded runes are compared by their code points.
+	OrdinalIgnoreCaseComparer Comparer[string] = ComparerFunc[string](compareFold)
+)
+
+// isDigit reports whether 'b' is an ASCII digit
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+// digitsPrefix returns the length of the prefix of 's' consisting of ASCII digits
+func digitsPrefix(s string) int {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return i
+}
+
+// compareNatural compares the strings treating the runs of ASCII digits as numbers,
+// other runes are compared after mapping them with 'mapping'
+func compareNatural(x, y string, mapping func(rune) rune) int {
+	// zeros - the result of comparing the first digit runs that differ only in the leading zeros
+	zeros := 0
+	for len(x) > 0 && len(y) > 0 {
+		if isDigit(x[0]) && isDigit(y[0]) {
+			nx, ny := digitsPrefix(x), digitsPrefix(y)
+			dx, dy := x[:nx], y[:ny]
+			// strip the leading zeros
+			zx, zy := 0, 0
+			for zx < len(dx)-1 && dx[zx] == '0' {
+				zx++
+			}
+			for zy < len(dy)-1 && dy[zy] == '0' {
+				zy++
+			}
+			if c := (Order[int]{}).Compare(len(dx)-zx, len(dy)-zy); c != 0 {
+				return c
+			}
+			if c := (Order[string]{}).Compare(dx[zx:], dy[zy:]); c != 0 {
+				return c
+			}
+			if zeros == 0 {
+				zeros = Order[int]{}.Compare(zx, zy)
+			}
+			x, y = x[nx:], y[ny:]
+			continue
+		}
+		rx, nx := utf8.DecodeRuneInString(x)
+		ry, ny := utf8.DecodeRuneInString(y)
+		if rx != ry {
+			rx, ry = mapping(rx), mapping(ry)
+			if rx < ry {
+				return -1
+			}
+			if rx > ry {
+				return +1
+			}
+		}
+		x, y = x[nx:], y[ny:]
+	}
+	switch {
+	case len(x) > 0:
+		return +1
+	case len(y) > 0:
+		return -1
+	}
+	return zeros
+}
+
+var (
+	// NaturalLesser is a Lesser for string that orders the runs of digits by their numeric values
+	// ("file2" is less than "file10"), see NaturalComparer.
+	NaturalLesser Lesser[string] = LesserFunc[string](func(x, y string) bool {
+		return compareNatural(x, y, Identity[rune]) < 0
+	})
+
+	// NaturalComparer is a Comparer for string that orders the runs of ASCII digits by their numeric values
+	// ("file2" is less than "file10"), the other runes are compared by their code points.
+	// The runs of digits of arbitrary length are supported.
+	// The strings that differ only in the leading zeros ("a01" and "a1") are ordered
+	// by the number of the leading zeros in the first differing run of digits.
+	NaturalComparer Comparer[string] = ComparerFunc[string](func(x, y string) int {
+		return compareNatural(x, y, Identity[rune])
+	})
+
+	// NaturalIgnoreCaseLesser is a case insensitive NaturalLesser.
+	NaturalIgnoreCaseLesser Lesser[string] = LesserFunc[string](func(x, y string) bool {
+		return compareNatural(x, y, foldRune) < 0
+	})
+
+	// NaturalIgnoreCaseComparer is a case insensitive NaturalComparer
+	// (the runes other than digits are compared as OrdinalIgnoreCaseComparer does).
+	NaturalIgnoreCaseComparer Comparer[string] = ComparerFunc[string](func(x, y string) int {
+		return compareNatural(x, y, foldRune)
+	})
+)
@@ -0,0 +1,139 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_CaseInsensitiveComparer_ToLower(t *testing.T) {
+	ss := []string{"", "a", "A", "ab", "aB", "b", "İ", "i", "I", "ı", "ß", "SS", "ǅ", "ǆ", "Ω", "ω", "\xff", "�", "z\xffa"}
+	for _, x := range ss {
+		for _, y := range ss {
+			want := Order[string]{}.Compare(strings.ToLower(x), strings.ToLower(y))
+			if got := CaseInsensitiveComparer.Compare(x, y); got != want {
+				t.Errorf("CaseInsensitiveComparer.Compare(%q, %q) = %v, want %v", x, y, got, want)
+			}
+			if want == 0 && CaseInsensitiveHasher.Hash(x) != CaseInsensitiveHasher.Hash(y) {
+				t.Errorf("CaseInsensitiveHasher.Hash(%q) != CaseInsensitiveHasher.Hash(%q)", x, y)
+			}
+		}
+	}
+}
+
+func Test_OrdinalIgnoreCaseComparer(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y string
+		want int
+	}{
+		{name: "Empty", x: "", y: "", want: 0},
+		{name: "ASCII", x: "Hello", y: "hELLO", want: 0},
+		{name: "Less", x: "apple", y: "Banana", want: -1},
+		{name: "Greater", x: "b", y: "A", want: +1},
+		{name: "Prefix", x: "ab", y: "AbC", want: -1},
+		{name: "Kelvin", x: "K", y: "k", want: 0},
+		{name: "LongS", x: "ſ", y: "S", want: 0},
+		{name: "Greek", x: "ΣΑΣ", y: "σας", want: 0},
+		{name: "TitleCase", x: "ǅ", y: "ǆ", want: 0},
+		{name: "DottedI", x: "İ", y: "i", want: +1},
+		{name: "SharpS", x: "ß", y: "ss", want: +1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OrdinalIgnoreCaseComparer.Compare(tt.x, tt.y); got != tt.want {
+				t.Errorf("OrdinalIgnoreCaseComparer.Compare() = %v, want %v", got, tt.want)
+			}
+			if got := OrdinalIgnoreCaseEqualer.Equal(tt.x, tt.y); got != strings.EqualFold(tt.x, tt.y) {
+				t.Errorf("OrdinalIgnoreCaseEqualer.Equal() = %v, want %v", got, strings.EqualFold(tt.x, tt.y))
+			}
+			if tt.want == 0 && OrdinalIgnoreCaseHasher.Hash(tt.x) != OrdinalIgnoreCaseHasher.Hash(tt.y) {
+				t.Errorf("OrdinalIgnoreCaseHasher.Hash(%q) != OrdinalIgnoreCaseHasher.Hash(%q)", tt.x, tt.y)
+			}
+		})
+	}
+}
+
+func Test_IgnoreCase_allocations(t *testing.T) {
+	x, y := "Straße ΣΑΣ Hello", "STRASSE σας hello"
+	tests := []struct {
+		name string
+		cmp  Comparer[string]
+	}{
+		{name: "CaseInsensitiveComparer", cmp: CaseInsensitiveComparer},
+		{name: "OrdinalIgnoreCaseComparer", cmp: OrdinalIgnoreCaseComparer},
+		{name: "NaturalIgnoreCaseComparer", cmp: NaturalIgnoreCaseComparer},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if n := testing.AllocsPerRun(100, func() { tt.cmp.Compare(x, y) }); n != 0 {
+				t.Errorf("%s.Compare() allocations = %v, want 0", tt.name, n)
+			}
+		})
+	}
+}
+
+func Test_NaturalComparer(t *testing.T) {
+	tests := []struct {
+		name   string
+		source Enumerator[string]
+		cmp    Comparer[string]
+		want   Enumerator[string]
+	}{
+		{name: "Files",
+			source: NewOnSlice("file10", "file2", "file1", "file20", "file3"),
+			cmp:    NaturalComparer,
+			want:   NewOnSlice("file1", "file2", "file3", "file10", "file20"),
+		},
+		{name: "SeveralNumbers",
+			source: NewOnSlice("v1.10.0", "v1.2.10", "v1.2.9", "v1.9"),
+			cmp:    NaturalComparer,
+			want:   NewOnSlice("v1.2.9", "v1.2.10", "v1.9", "v1.10.0"),
+		},
+		{name: "LeadingZeros",
+			source: NewOnSlice("a001", "a1", "a01", "a2", "a0"),
+			cmp:    NaturalComparer,
+			want:   NewOnSlice("a0", "a1", "a01", "a001", "a2"),
+		},
+		{name: "HugeNumbers",
+			source: NewOnSlice("n123456789012345678901234567890", "n99999999999999999999", "n5"),
+			cmp:    NaturalComparer,
+			want:   NewOnSlice("n5", "n99999999999999999999", "n123456789012345678901234567890"),
+		},
+		{name: "DigitsBeforeLetters",
+			source: NewOnSlice("b", "10", "a", "9"),
+			cmp:    NaturalComparer,
+			want:   NewOnSlice("9", "10", "a", "b"),
+		},
+		{name: "CaseSensitive",
+			source: NewOnSlice("b2", "B10", "a1"),
+			cmp:    NaturalComparer,
+			want:   NewOnSlice("B10", "a1", "b2"),
+		},
+		{name: "IgnoreCase",
+			source: NewOnSlice("b2", "B10", "a1", "A3"),
+			cmp:    NaturalIgnoreCaseComparer,
+			want:   NewOnSlice("a1", "A3", "b2", "B10"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := OrderByCmpMust(tt.source, Identity[string], tt.cmp).GetEnumerator()
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("OrderByCmp() = '%v', want '%v'", String(got), String(tt.want))
+			}
+		})
+	}
+}
+
+func Test_NaturalLesser(t *testing.T) {
+	if !NaturalLesser.Less("file2", "file10") {
+		t.Errorf("NaturalLesser.Less(\"file2\", \"file10\") = false, want true")
+	}
+	if NaturalIgnoreCaseLesser.Less("FILE10", "file2") {
+		t.Errorf("NaturalIgnoreCaseLesser.Less(\"FILE10\", \"file2\") = true, want false")
+	}
+}
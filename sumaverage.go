@@ -34,6 +34,9 @@ func Sum[Source any, Result constraints.Integer | constraints.Float](source Enum
 		return 0, ErrNilSelector
 	}
 	r, _ := sumPrim(source, selector)
+	if err := Err(source); err != nil {
+		return 0, err
+	}
 	return r, nil
 }
 
@@ -56,6 +59,9 @@ func Average[Source any, Result constraints.Integer | constraints.Float](source
 		return 0, ErrNilSelector
 	}
 	sum, count := sumPrim(source, selector)
+	if err := Err(source); err != nil {
+		return 0, err
+	}
 	if count == 0 {
 		return 0, ErrEmptySource
 	}
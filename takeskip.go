@@ -19,17 +19,28 @@ func Take[Source any](source Enumerator[Source], count int) (Enumerator[Source],
 	if count <= 0 {
 		return Empty[Source](), nil
 	}
+	if op, ok := source.(orderedPartition[Source]); ok && op.fresh() {
+		return op.take(count), nil
+	}
 	i := 0
+	closed := false
 	return OnFunc[Source]{
 			mvNxt: func() bool {
 				if i < count && source.MoveNext() {
 					i++
 					return true
 				}
+				if i >= count && !closed {
+					// the rest of 'source' is not needed
+					closed = true
+					Close(source)
+				}
 				return false
 			},
 			crrnt: func() Source { return source.Current() },
-			rst:   func() { i = 0; source.Reset() },
+			rst:   func() { i = 0; closed = false; source.Reset() },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
 		},
 		nil
 }
@@ -52,6 +63,9 @@ func TakeLast[Source any](source Enumerator[Source], count int) (Enumerator[Sour
 		return Empty[Source](), nil
 	}
 	sl := Slice(source)
+	if err := Err(source); err != nil {
+		return nil, err
+	}
 	return NewOnSlice(sl[len(sl)-count:]...), nil
 }
 
@@ -84,12 +98,16 @@ func TakeWhile[Source any](source Enumerator[Source], predicate func(Source) boo
 					if predicate(c) {
 						return true
 					}
+					// the rest of 'source' is not needed
+					Close(source)
 				}
 				enough = true
 				return false
 			},
 			crrnt: func() Source { return c },
 			rst:   func() { enough = false; source.Reset() },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
 		},
 		nil
 }
@@ -126,12 +144,16 @@ func TakeWhileIdx[Source any](source Enumerator[Source], predicate func(Source,
 					if predicate(c, i) {
 						return true
 					}
+					// the rest of 'source' is not needed
+					Close(source)
 				}
 				enough = true
 				return false
 			},
 			crrnt: func() Source { return c },
 			rst:   func() { enough = false; i = -1; source.Reset() },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
 		},
 		nil
 }
@@ -166,6 +188,8 @@ func Skip[Source any](source Enumerator[Source], count int) (Enumerator[Source],
 			},
 			crrnt: func() Source { return source.Current() },
 			rst:   func() { i = 1; source.Reset() },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
 		},
 		nil
 }
@@ -189,6 +213,9 @@ func SkipLast[Source any](source Enumerator[Source], count int) (Enumerator[Sour
 		return source, nil
 	}
 	sl := Slice(source)
+	if err := Err(source); err != nil {
+		return nil, err
+	}
 	return NewOnSlice(sl[:len(sl)-count]...), nil
 }
 
@@ -227,6 +254,8 @@ func SkipWhile[Source any](source Enumerator[Source], predicate func(Source) boo
 			},
 			crrnt: func() Source { return c },
 			rst:   func() { remaining = false; source.Reset() },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
 		},
 		nil
 }
@@ -269,6 +298,8 @@ func SkipWhileIdx[Source any](source Enumerator[Source], predicate func(Source,
 			},
 			crrnt: func() Source { return c },
 			rst:   func() { remaining = false; i = -1; source.Reset() },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
 		},
 		nil
 }
@@ -0,0 +1,187 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"constraints"
+	"math"
+	"sort"
+)
+
+// https://arxiv.org/abs/1902.04023
+// https://github.com/tdunning/t-digest
+
+// DefaultTDigestCompression is the compression of the TDigest used by Quantiles.
+const DefaultTDigestCompression = 100
+
+// tdCentroid is a cluster of values summarized by their mean and count
+type tdCentroid struct {
+	mean, weight float64
+}
+
+// TDigest is a merging t-digest, a sketch that estimates the quantiles of a stream of values
+// in bounded memory (O(compression) centroids regardless of the number of values).
+// The estimates are most accurate for the extreme quantiles.
+//
+// TDigest suits the unbounded sequences: the values may be added as they come
+// (e.g. from ForEach or an Observable) and the quantiles may be estimated at any time.
+// TDigest is not safe for concurrent use.
+type TDigest struct {
+	compression float64
+	// centroids - the merged centroids sorted by mean, buf - the values not yet merged
+	centroids []tdCentroid
+	buf       []tdCentroid
+	count     float64
+	min, max  float64
+}
+
+// NewTDigest creates a new TDigest with a specified compression.
+// The larger the compression, the more accurate the estimates and the more memory is used
+// (DefaultTDigestCompression is a reasonable choice).
+// If 'compression' is not positive, ErrSizeOutOfRange is returned.
+func NewTDigest(compression float64) (*TDigest, error) {
+	if !(compression > 0) {
+		return nil, ErrSizeOutOfRange
+	}
+	return &TDigest{
+		compression: compression,
+		buf:         make([]tdCentroid, 0, int(5*compression)+1),
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}, nil
+}
+
+// NewTDigestMust is like NewTDigest but panics in case of error.
+func NewTDigestMust(compression float64) *TDigest {
+	r, err := NewTDigest(compression)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Add adds a value to the digest.
+func (td *TDigest) Add(x float64) {
+	if len(td.buf) == cap(td.buf) {
+		td.merge()
+	}
+	td.buf = append(td.buf, tdCentroid{mean: x, weight: 1})
+	td.count++
+	td.min, td.max = math.Min(td.min, x), math.Max(td.max, x)
+}
+
+// Count returns the number of values added to the digest.
+func (td *TDigest) Count() int {
+	return int(td.count)
+}
+
+// k is the scale function k1 of the t-digest paper, it maps the quantile to the centroid index space
+func (td *TDigest) k(q float64) float64 {
+	return td.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// kInv is the inverse of k
+func (td *TDigest) kInv(k float64) float64 {
+	return (math.Sin(k*2*math.Pi/td.compression) + 1) / 2
+}
+
+// merge merges the buffered values into the centroids
+func (td *TDigest) merge() {
+	if len(td.buf) == 0 {
+		return
+	}
+	all := append(td.centroids, td.buf...)
+	td.buf = td.buf[:0]
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+	merged := make([]tdCentroid, 0, len(all))
+	cur := all[0]
+	var wSoFar float64
+	qLimit := td.kInv(td.k(0) + 1)
+	for _, c := range all[1:] {
+		if (wSoFar+cur.weight+c.weight)/td.count <= qLimit {
+			cur.weight += c.weight
+			cur.mean += (c.mean - cur.mean) * c.weight / cur.weight
+			continue
+		}
+		merged = append(merged, cur)
+		wSoFar += cur.weight
+		qLimit = td.kInv(td.k(wSoFar/td.count) + 1)
+		cur = c
+	}
+	td.centroids = append(merged, cur)
+}
+
+// Quantile estimates the 'q'-th quantile (0 <= q <= 1) of the values added to the digest.
+// If no values have been added or 'q' is out of range, NaN is returned.
+func (td *TDigest) Quantile(q float64) float64 {
+	if td.count == 0 || !(0 <= q && q <= 1) {
+		return math.NaN()
+	}
+	td.merge()
+	cc := td.centroids
+	if len(cc) == 1 {
+		return cc[0].mean
+	}
+	// each centroid is considered to be centered at the middle of its weight
+	index := q * td.count
+	if index <= cc[0].weight/2 {
+		return td.min + (cc[0].mean-td.min)*index/(cc[0].weight/2)
+	}
+	last := cc[len(cc)-1]
+	if index >= td.count-last.weight/2 {
+		return last.mean + (td.max-last.mean)*(index-(td.count-last.weight/2))/(last.weight/2)
+	}
+	center := cc[0].weight / 2
+	for i := 1; i < len(cc); i++ {
+		next := center + (cc[i-1].weight+cc[i].weight)/2
+		if index <= next {
+			return cc[i-1].mean + (cc[i].mean-cc[i-1].mean)*(index-center)/(next-center)
+		}
+		center = next
+	}
+	return td.max
+}
+
+// Quantiles estimates the 'qs' quantiles (0 <= q <= 1) of a sequence of values that are obtained
+// by invoking a transform function on each element of the input sequence.
+// A TDigest with DefaultTDigestCompression is used, so the values are not buffered
+// (use TDigest directly to estimate the quantiles of an unbounded sequence).
+func Quantiles[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	selector func(Source) Result, qs ...float64) ([]float64, error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if selector == nil {
+		return nil, ErrNilSelector
+	}
+	for _, q := range qs {
+		if !(0 <= q && q <= 1) {
+			return nil, ErrQuantileOutOfRange
+		}
+	}
+	td := NewTDigestMust(DefaultTDigestCompression)
+	for source.MoveNext() {
+		td.Add(float64(selector(source.Current())))
+	}
+	if err := Err(source); err != nil {
+		return nil, err
+	}
+	if td.Count() == 0 {
+		return nil, ErrEmptySource
+	}
+	r := make([]float64, len(qs))
+	for i, q := range qs {
+		r[i] = td.Quantile(q)
+	}
+	return r, nil
+}
+
+// QuantilesMust is like Quantiles but panics in case of error.
+func QuantilesMust[Source any, Result constraints.Integer | constraints.Float](source Enumerator[Source],
+	selector func(Source) Result, qs ...float64) []float64 {
+	r, err := Quantiles(source, selector, qs...)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
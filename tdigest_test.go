@@ -0,0 +1,75 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func Test_TDigest_small(t *testing.T) {
+	// few values are not merged, so the quantiles at the centers of the values are exact
+	td := NewTDigestMust(DefaultTDigestCompression)
+	for _, x := range []float64{5, 1, 4, 2, 3} {
+		td.Add(x)
+	}
+	if got := td.Count(); got != 5 {
+		t.Errorf("TDigest.Count() = %v, want 5", got)
+	}
+	tests := []struct {
+		q    float64
+		want float64
+	}{
+		{q: 0, want: 1},
+		{q: 0.1, want: 1},
+		{q: 0.5, want: 3},
+		{q: 0.7, want: 4},
+		{q: 1, want: 5},
+	}
+	for _, tt := range tests {
+		if got := td.Quantile(tt.q); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("TDigest.Quantile(%v) = %v, want %v", tt.q, got, tt.want)
+		}
+	}
+	if got := td.Quantile(1.5); !math.IsNaN(got) {
+		t.Errorf("TDigest.Quantile(1.5) = %v, want NaN", got)
+	}
+}
+
+func Test_TDigest_large(t *testing.T) {
+	const n = 100000
+	rnd := rand.New(rand.NewSource(1))
+	td := NewTDigestMust(DefaultTDigestCompression)
+	for i := 0; i < n; i++ {
+		td.Add(rnd.Float64())
+	}
+	if len(td.centroids)+len(td.buf) > 10*DefaultTDigestCompression {
+		t.Errorf("TDigest holds %d centroids", len(td.centroids)+len(td.buf))
+	}
+	// the quantiles of the uniform distribution on [0, 1) are equal to q
+	for _, q := range []float64{0.001, 0.01, 0.1, 0.25, 0.5, 0.75, 0.9, 0.99, 0.999} {
+		if got := td.Quantile(q); math.Abs(got-q) > 0.01 {
+			t.Errorf("TDigest.Quantile(%v) = %v, want ~%v", q, got, q)
+		}
+	}
+}
+
+func Test_QuantilesMust(t *testing.T) {
+	got := QuantilesMust(RangeMust(1, 1000), Identity[int], 0, 0.5, 1)
+	want := []float64{1, 500.5, 1000}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 5 {
+			t.Errorf("Quantiles()[%d] = %v, want ~%v", i, got[i], want[i])
+		}
+	}
+	if _, err := Quantiles(Empty[int](), Identity[int], 0.5); err != ErrEmptySource {
+		t.Errorf("Quantiles() error = '%v', want '%v'", err, ErrEmptySource)
+	}
+	if _, err := Quantiles(NewOnSliceEn(1), Identity[int], -0.5); err != ErrQuantileOutOfRange {
+		t.Errorf("Quantiles() error = '%v', want '%v'", err, ErrQuantileOutOfRange)
+	}
+	if _, err := NewTDigest(0); err != ErrSizeOutOfRange {
+		t.Errorf("NewTDigest() error = '%v', want '%v'", err, ErrSizeOutOfRange)
+	}
+}
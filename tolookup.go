@@ -29,6 +29,7 @@ func ToLookupMust[Source, Key any](source Enumerator[Source], keySelector func(S
 
 // ToLookupEq creates a Lookup from an Enumerator according to a specified key selector function and a key equaler.
 // If 'equaler' is nil reflect.DeepEqual is used. 'source' is enumerated immediately.
+// If 'equaler' implements Hasher, the Lookup's keys are indexed with the help of it.
 func ToLookupEq[Source, Key any](source Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) (*Lookup[Key, Source], error) {
 	if source == nil {
 		return nil, ErrNilSource
@@ -45,6 +46,9 @@ func ToLookupEq[Source, Key any](source Enumerator[Source], keySelector func(Sou
 		k := keySelector(c)
 		lk.add(k, c)
 	}
+	if err := Err(source); err != nil {
+		return nil, err
+	}
 	return lk, nil
 }
 
@@ -83,6 +87,7 @@ func ToLookupSelMust[Source, Key, Element any](source Enumerator[Source],
 // ToLookupSelEq creates a Lookup from an Enumerator according to a specified key selector function,
 // an element selector function and a key equaler.
 // If 'equaler' is nil reflect.DeepEqual is used. 'source' is enumerated immediately.
+// If 'equaler' implements Hasher, the Lookup's keys are indexed with the help of it.
 func ToLookupSelEq[Source, Key, Element any](source Enumerator[Source],
 	keySelector func(Source) Key, elementSelector func(Source) Element, equaler Equaler[Key]) (*Lookup[Key, Element], error) {
 	if source == nil {
@@ -100,6 +105,9 @@ func ToLookupSelEq[Source, Key, Element any](source Enumerator[Source],
 		k := keySelector(c)
 		lk.add(k, elementSelector(c))
 	}
+	if err := Err(source); err != nil {
+		return nil, err
+	}
 	return lk, nil
 }
 
@@ -112,3 +120,118 @@ func ToLookupSelEqMust[Source, Key, Element any](source Enumerator[Source],
 	}
 	return r
 }
+
+// ToLookupHash creates a Lookup from an Enumerator according to a specified key selector function and a key hasher.
+// The Lookup's keys are indexed with the help of 'hasher'. 'source' is enumerated immediately.
+func ToLookupHash[Source, Key any](source Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) (*Lookup[Key, Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return ToLookupSelHash(source, keySelector, Identity[Source], hasher)
+}
+
+// ToLookupHashMust is like ToLookupHash but panics in case of error.
+func ToLookupHashMust[Source, Key any](source Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) *Lookup[Key, Source] {
+	r, err := ToLookupHash(source, keySelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ToLookupSelHash creates a Lookup from an Enumerator according to a specified key selector function,
+// an element selector function and a key hasher.
+// The Lookup's keys are indexed with the help of 'hasher'. 'source' is enumerated immediately.
+func ToLookupSelHash[Source, Key, Element any](source Enumerator[Source],
+	keySelector func(Source) Key, elementSelector func(Source) Element, hasher Hasher[Key]) (*Lookup[Key, Element], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil || elementSelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	lk := newLookupHash[Key, Element](hasher)
+	for source.MoveNext() {
+		c := source.Current()
+		k := keySelector(c)
+		lk.add(k, elementSelector(c))
+	}
+	if err := Err(source); err != nil {
+		return nil, err
+	}
+	return lk, nil
+}
+
+// ToLookupSelHashMust is like ToLookupSelHash but panics in case of error.
+func ToLookupSelHashMust[Source, Key, Element any](source Enumerator[Source],
+	keySelector func(Source) Key, elementSelector func(Source) Element, hasher Hasher[Key]) *Lookup[Key, Element] {
+	r, err := ToLookupSelHash(source, keySelector, elementSelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ToLookupComparable creates a Lookup from an Enumerator according to a specified key selector function.
+// Keys are compared using == and the Lookup's keys are indexed with the help of a map.
+// 'source' is enumerated immediately.
+func ToLookupComparable[Source any, Key comparable](source Enumerator[Source], keySelector func(Source) Key) (*Lookup[Key, Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	return ToLookupSelComparable(source, keySelector, Identity[Source])
+}
+
+// ToLookupComparableMust is like ToLookupComparable but panics in case of error.
+func ToLookupComparableMust[Source any, Key comparable](source Enumerator[Source], keySelector func(Source) Key) *Lookup[Key, Source] {
+	r, err := ToLookupComparable(source, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ToLookupSelComparable creates a Lookup from an Enumerator according to specified key selector and element selector functions.
+// Keys are compared using == and the Lookup's keys are indexed with the help of a map.
+// 'source' is enumerated immediately.
+func ToLookupSelComparable[Source any, Key comparable, Element any](source Enumerator[Source],
+	keySelector func(Source) Key, elementSelector func(Source) Element) (*Lookup[Key, Element], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil || elementSelector == nil {
+		return nil, ErrNilSelector
+	}
+	lk := newLookupComparable[Key, Element]()
+	for source.MoveNext() {
+		c := source.Current()
+		k := keySelector(c)
+		lk.add(k, elementSelector(c))
+	}
+	if err := Err(source); err != nil {
+		return nil, err
+	}
+	return lk, nil
+}
+
+// ToLookupSelComparableMust is like ToLookupSelComparable but panics in case of error.
+func ToLookupSelComparableMust[Source any, Key comparable, Element any](source Enumerator[Source],
+	keySelector func(Source) Key, elementSelector func(Source) Element) *Lookup[Key, Element] {
+	r, err := ToLookupSelComparable(source, keySelector, elementSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
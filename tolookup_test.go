@@ -142,3 +142,57 @@ func TestEnumerable_ToLookupEq(t *testing.T) {
 		})
 	}
 }
+
+func TestEnumerable_ToLookupHash(t *testing.T) {
+	lk := newLookup[string, string]()
+	lk.add("abc", "abc")
+	lk.add("def", "def")
+	lk.add("abc", "ABC")
+	type args struct {
+		source      Enumerator[string]
+		keySelector func(string) string
+		hasher      Hasher[string]
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Lookup[string, string]
+	}{
+		{name: "LookupWithHasherButNoElementSelector",
+			args: args{
+				source:      NewOnSlice("abc", "def", "ABC"),
+				keySelector: Identity[string],
+				hasher:      CaseInsensitiveHasher,
+			},
+			want: lk},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := ToLookupHash(tt.args.source, tt.args.keySelector, tt.args.hasher)
+			if !got.Equal(tt.want) {
+				t.Errorf("ToLookupHash() = %v, want %v", got, tt.want)
+			}
+			if !got.Contains("DEF") || got.Contains("xyz") {
+				t.Errorf("ToLookupHash().Contains() is wrong")
+			}
+		})
+	}
+}
+
+func TestEnumerable_ToLookupComparable(t *testing.T) {
+	lk := newLookup[int, string]()
+	lk.add(3, "abc")
+	lk.add(3, "def")
+	lk.add(1, "x")
+	lk.add(1, "y")
+	lk.add(3, "ghi")
+	lk.add(1, "z")
+	lk.add(2, "00")
+	got := ToLookupComparableMust(NewOnSliceEn("abc", "def", "x", "y", "ghi", "z", "00"), func(s string) int { return len(s) })
+	if !got.Equal(lk) {
+		t.Errorf("ToLookupComparable() = %v, want %v", got, lk)
+	}
+	if got.Count() != 3 || len(got.ItemSlice(1)) != 3 || len(got.ItemSlice(4)) != 0 {
+		t.Errorf("ToLookupComparable() = %v, want %v", got, lk)
+	}
+}
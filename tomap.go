@@ -23,10 +23,14 @@ func ToMap[Source any, Key comparable](source Enumerator[Source], keySelector fu
 		// 	return nil, ErrNilKey
 		// }
 		if _, ok := r[k]; ok {
+			Close(source)
 			return nil, ErrDuplicateKeys
 		}
 		r[k] = c
 	}
+	if err := Err(source); err != nil {
+		return nil, err
+	}
 	return r, nil
 }
 
@@ -63,10 +67,14 @@ func ToMapSel[Source any, Key comparable, Element any](source Enumerator[Source]
 		//   panic(ErrNilKey)
 		// }
 		if _, ok := r[k]; ok {
+			Close(source)
 			return nil, ErrDuplicateKeys
 		}
 		r[k] = elementSelector(c)
 	}
+	if err := Err(source); err != nil {
+		return nil, err
+	}
 	return r, nil
 }
 
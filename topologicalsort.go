@@ -0,0 +1,132 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"fmt"
+	"sync"
+)
+
+// https://en.wikipedia.org/wiki/Topological_sorting#Depth-first_search
+
+// topologicalSort sorts 'nodes' so that each node follows its dependencies.
+// 'newLookup' creates the Lookup used to identify the nodes.
+func topologicalSort[T any](nodes Enumerator[T], deps func(T) Enumerator[T], newLookup func() *Lookup[T, int]) Enumerator[T] {
+	var once sync.Once
+	var sorted []T
+	var err error
+	i := -1
+	return OnFunc[T]{
+		mvNxt: func() bool {
+			once.Do(func() {
+				// lk maps the nodes to their positions in 'state':
+				// 0 - not visited, 1 - being visited (on the current path), 2 - visited
+				lk := newLookup()
+				var state []int8
+				var visit func(n T) error
+				visit = func(n T) error {
+					j := lk.keyIndex(n)
+					if j < 0 {
+						lk.add(n, len(state))
+						state = append(state, 0)
+						j = len(state) - 1
+					}
+					switch state[j] {
+					case 1:
+						return fmt.Errorf("%w: %v", ErrCycle, n)
+					case 2:
+						return nil
+					}
+					state[j] = 1
+					if en := deps(n); en != nil {
+						for en.MoveNext() {
+							if err := visit(en.Current()); err != nil {
+								Close(en)
+								return err
+							}
+						}
+						err := Err(en)
+						Close(en)
+						if err != nil {
+							return err
+						}
+					}
+					state[j] = 2
+					sorted = append(sorted, n)
+					return nil
+				}
+				for nodes.MoveNext() {
+					if err = visit(nodes.Current()); err != nil {
+						sorted = nil
+						Close(nodes)
+						return
+					}
+				}
+				err = Err(nodes)
+			})
+			if err != nil || i+1 >= len(sorted) {
+				return false
+			}
+			i++
+			return true
+		},
+		crrnt: func() T {
+			if i < 0 || i >= len(sorted) {
+				return ZeroValue[T]()
+			}
+			return sorted[i]
+		},
+		rst: func() { i = -1 },
+		err: func() error { return err },
+		cls: func() error { return Close(nodes) },
+	}
+}
+
+// TopologicalSort sorts the nodes of a directed acyclic graph so that each node follows its dependencies
+// (returned by 'deps', nil or an empty Enumerator if the node has no dependencies).
+// The dependencies not contained in 'nodes' are included in the result as well.
+// reflect.DeepEqual is used to identify the nodes.
+// The nodes are sorted on the first call to MoveNext (with the help of depth-first search),
+// the order of independent nodes follows their order in 'nodes'.
+// If the graph has a cycle, the enumeration stops and an error wrapping ErrCycle
+// is returned by the Err method of the resulting Enumerator (see ErrEnumerator).
+func TopologicalSort[T any](nodes Enumerator[T], deps func(T) Enumerator[T]) (Enumerator[T], error) {
+	if nodes == nil {
+		return nil, ErrNilSource
+	}
+	if deps == nil {
+		return nil, ErrNilSelector
+	}
+	return topologicalSort(nodes, deps, lookupEqFactory[T](nil)), nil
+}
+
+// TopologicalSortMust is like TopologicalSort but panics in case of error.
+func TopologicalSortMust[T any](nodes Enumerator[T], deps func(T) Enumerator[T]) Enumerator[T] {
+	r, err := TopologicalSort(nodes, deps)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// TopologicalSortEq sorts the nodes of a directed acyclic graph so that each node follows its dependencies
+// using a specified Equaler to identify the nodes. If 'equaler' is nil reflect.DeepEqual is used.
+// (See TopologicalSort function.)
+func TopologicalSortEq[T any](nodes Enumerator[T], deps func(T) Enumerator[T], equaler Equaler[T]) (Enumerator[T], error) {
+	if nodes == nil {
+		return nil, ErrNilSource
+	}
+	if deps == nil {
+		return nil, ErrNilSelector
+	}
+	return topologicalSort(nodes, deps, lookupEqFactory(equaler)), nil
+}
+
+// TopologicalSortEqMust is like TopologicalSortEq but panics in case of error.
+func TopologicalSortEqMust[T any](nodes Enumerator[T], deps func(T) Enumerator[T], equaler Equaler[T]) Enumerator[T] {
+	r, err := TopologicalSortEq(nodes, deps, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,61 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_TopologicalSortMust(t *testing.T) {
+	deps := map[string][]string{
+		"app":    {"lib", "log"},
+		"lib":    {"core"},
+		"log":    {"core", "fmt"},
+		"core":   nil,
+		"test":   {"app"},
+		"single": nil,
+	}
+	got := TopologicalSortMust(NewOnSliceEn("test", "single", "lib"), func(s string) Enumerator[string] {
+		return NewOnSliceEn(deps[s]...)
+	})
+	want := NewOnSliceEn("core", "lib", "fmt", "log", "app", "test", "single")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("TopologicalSort() = '%v', want '%v'", String(got), String(want))
+	}
+	got.Reset()
+	want.Reset()
+	if !SequenceEqualMust(got, want) {
+		t.Errorf("TopologicalSort() after Reset differs")
+	}
+}
+
+func Test_TopologicalSortEqMust(t *testing.T) {
+	deps := func(s string) Enumerator[string] {
+		if s == "a" {
+			return NewOnSliceEn("b")
+		}
+		return nil
+	}
+	got := TopologicalSortEqMust(NewOnSliceEn("a", "B"), deps, CaseInsensitiveEqualer)
+	want := NewOnSliceEn("b", "a")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("TopologicalSortEq() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_TopologicalSort_cycle(t *testing.T) {
+	got := TopologicalSortMust(NewOnSliceEn(1, 4), func(n int) Enumerator[int] {
+		return NewOnSliceEn((n + 1) % 3)
+	})
+	if _, err := SliceErr(got); !errors.Is(err, ErrCycle) {
+		t.Errorf("TopologicalSort() error = '%v', want '%v'", err, ErrCycle)
+	}
+	if _, err := TopologicalSort[int](NewOnSliceEn(1), nil); err != ErrNilSelector {
+		t.Errorf("TopologicalSort() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+}
@@ -0,0 +1,308 @@
+//go:build go1.18
+
+package go2linq
+
+// https://morelinq.github.io/3.3/ref/api/html/M_MoreLinq_MoreEnumerable_TraverseDepthFirst__1.htm
+// https://morelinq.github.io/3.3/ref/api/html/M_MoreLinq_MoreEnumerable_TraverseBreadthFirst__1.htm
+
+// TraversalNode is a node visited during a traversal with its depth and path (see TraverseDepthFirstPath).
+type TraversalNode[T any] struct {
+	node  T
+	depth int
+	path  []T
+}
+
+// Node returns the visited node.
+func (tn *TraversalNode[T]) Node() T {
+	return tn.node
+}
+
+// Depth returns the depth of the node (0 for the root).
+func (tn *TraversalNode[T]) Depth() int {
+	return tn.depth
+}
+
+// Path returns the nodes from the root to the node (inclusive).
+func (tn *TraversalNode[T]) Path() []T {
+	return tn.path
+}
+
+// traverse returns the nodes reachable from 'root' in depth-first (preorder) or breadth-first order.
+// If 'newLookup' is not nil, it creates the Lookup used to skip the already visited nodes.
+// If 'withPath' is true, the paths of the nodes are computed.
+func traverse[T any](root T, children func(T) Enumerator[T], newLookup func() *Lookup[T, int],
+	depthFirst, withPath bool) Enumerator[TraversalNode[T]] {
+	var visited *Lookup[T, int]
+	// stack - the children enumerators of the nodes on the current path (depth-first),
+	// queue - the nodes to visit (breadth-first)
+	var stack []Enumerator[T]
+	var queue []TraversalNode[T]
+	var c TraversalNode[T]
+	var started, expand bool
+	var err error
+	// visit reports whether 'n' has not been visited yet and marks it as visited
+	visit := func(n T) bool {
+		if visited == nil {
+			return true
+		}
+		if visited.Contains(n) {
+			return false
+		}
+		visited.add(n, 0)
+		return true
+	}
+	// child returns the TraversalNode of the child 'n' of the node 'p'
+	child := func(p TraversalNode[T], n T) TraversalNode[T] {
+		r := TraversalNode[T]{node: n, depth: p.depth + 1}
+		if withPath {
+			r.path = append(append(make([]T, 0, len(p.path)+1), p.path...), n)
+		}
+		return r
+	}
+	// cur - the TraversalNodes of the nodes on the current path (depth-first)
+	var cur []TraversalNode[T]
+	closeAll := func() {
+		for _, en := range stack {
+			Close(en)
+		}
+		stack, cur = nil, nil
+	}
+	return OnFunc[TraversalNode[T]]{
+		mvNxt: func() bool {
+			if err != nil {
+				return false
+			}
+			if !started {
+				started = true
+				if newLookup != nil {
+					visited = newLookup()
+				}
+				visit(root)
+				c = TraversalNode[T]{node: root}
+				if withPath {
+					c.path = []T{root}
+				}
+				expand = true
+				return true
+			}
+			if depthFirst {
+				if expand {
+					expand = false
+					if en := children(c.node); en != nil {
+						stack = append(stack, en)
+						cur = append(cur, c)
+					}
+				}
+				for len(stack) > 0 {
+					en := stack[len(stack)-1]
+					if en.MoveNext() {
+						n := en.Current()
+						if !visit(n) {
+							continue
+						}
+						c = child(cur[len(cur)-1], n)
+						expand = true
+						return true
+					}
+					if err = Err(en); err != nil {
+						closeAll()
+						return false
+					}
+					Close(en)
+					stack, cur = stack[:len(stack)-1], cur[:len(cur)-1]
+				}
+				return false
+			}
+			if expand {
+				expand = false
+				if en := children(c.node); en != nil {
+					for en.MoveNext() {
+						if n := en.Current(); visit(n) {
+							queue = append(queue, child(c, n))
+						}
+					}
+					err = Err(en)
+					Close(en)
+					if err != nil {
+						return false
+					}
+				}
+			}
+			if len(queue) == 0 {
+				return false
+			}
+			c, queue = queue[0], queue[1:]
+			expand = true
+			return true
+		},
+		crrnt: func() TraversalNode[T] { return c },
+		rst: func() {
+			closeAll()
+			queue, started, expand, err = nil, false, false, nil
+		},
+		err: func() error { return err },
+		cls: func() error {
+			closeAll()
+			return nil
+		},
+	}
+}
+
+// traverseNodes returns the nodes of the traversal
+func traverseNodes[T any](en Enumerator[TraversalNode[T]]) Enumerator[T] {
+	return SelectMust(en, func(tn TraversalNode[T]) T { return tn.node })
+}
+
+// TraverseDepthFirst returns the nodes of a tree (starting with 'root') in depth-first order (preorder).
+// 'children' returns the children of a node (nil or an empty Enumerator if the node is a leaf).
+// The traversal is lazy: the children of a node are requested after the node is returned.
+// The nodes are not checked for being visited already, so the graph must not have cycles
+// (otherwise use TraverseDepthFirstEq).
+func TraverseDepthFirst[T any](root T, children func(T) Enumerator[T]) (Enumerator[T], error) {
+	if children == nil {
+		return nil, ErrNilSelector
+	}
+	return traverseNodes(traverse(root, children, nil, true, false)), nil
+}
+
+// TraverseDepthFirstMust is like TraverseDepthFirst but panics in case of error.
+func TraverseDepthFirstMust[T any](root T, children func(T) Enumerator[T]) Enumerator[T] {
+	r, err := TraverseDepthFirst(root, children)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// TraverseDepthFirstEq returns the nodes of a graph reachable from 'root' in depth-first order (preorder).
+// Each node is returned once: the nodes equal (according to 'equaler') to the already visited nodes are skipped,
+// so the graph may have cycles. If 'equaler' is nil reflect.DeepEqual is used.
+// If 'equaler' implements Hasher, the visited nodes are looked up in O(1) on average.
+// (See TraverseDepthFirst function.)
+func TraverseDepthFirstEq[T any](root T, children func(T) Enumerator[T], equaler Equaler[T]) (Enumerator[T], error) {
+	if children == nil {
+		return nil, ErrNilSelector
+	}
+	return traverseNodes(traverse(root, children, lookupEqFactory(equaler), true, false)), nil
+}
+
+// TraverseDepthFirstEqMust is like TraverseDepthFirstEq but panics in case of error.
+func TraverseDepthFirstEqMust[T any](root T, children func(T) Enumerator[T], equaler Equaler[T]) Enumerator[T] {
+	r, err := TraverseDepthFirstEq(root, children, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// TraverseDepthFirstPath is like TraverseDepthFirst
+// but returns each node with its depth and path from the root.
+func TraverseDepthFirstPath[T any](root T, children func(T) Enumerator[T]) (Enumerator[TraversalNode[T]], error) {
+	if children == nil {
+		return nil, ErrNilSelector
+	}
+	return traverse(root, children, nil, true, true), nil
+}
+
+// TraverseDepthFirstPathMust is like TraverseDepthFirstPath but panics in case of error.
+func TraverseDepthFirstPathMust[T any](root T, children func(T) Enumerator[T]) Enumerator[TraversalNode[T]] {
+	r, err := TraverseDepthFirstPath(root, children)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// TraverseDepthFirstPathEq is like TraverseDepthFirstEq
+// but returns each node with its depth and path from the root.
+func TraverseDepthFirstPathEq[T any](root T, children func(T) Enumerator[T], equaler Equaler[T]) (Enumerator[TraversalNode[T]], error) {
+	if children == nil {
+		return nil, ErrNilSelector
+	}
+	return traverse(root, children, lookupEqFactory(equaler), true, true), nil
+}
+
+// TraverseDepthFirstPathEqMust is like TraverseDepthFirstPathEq but panics in case of error.
+func TraverseDepthFirstPathEqMust[T any](root T, children func(T) Enumerator[T], equaler Equaler[T]) Enumerator[TraversalNode[T]] {
+	r, err := TraverseDepthFirstPathEq(root, children, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// TraverseBreadthFirst returns the nodes of a tree (starting with 'root') in breadth-first (level) order.
+// 'children' returns the children of a node (nil or an empty Enumerator if the node is a leaf).
+// The traversal is lazy: the children of a node are requested after the node is returned.
+// The nodes are not checked for being visited already, so the graph must not have cycles
+// (otherwise use TraverseBreadthFirstEq).
+func TraverseBreadthFirst[T any](root T, children func(T) Enumerator[T]) (Enumerator[T], error) {
+	if children == nil {
+		return nil, ErrNilSelector
+	}
+	return traverseNodes(traverse(root, children, nil, false, false)), nil
+}
+
+// TraverseBreadthFirstMust is like TraverseBreadthFirst but panics in case of error.
+func TraverseBreadthFirstMust[T any](root T, children func(T) Enumerator[T]) Enumerator[T] {
+	r, err := TraverseBreadthFirst(root, children)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// TraverseBreadthFirstEq returns the nodes of a graph reachable from 'root' in breadth-first order.
+// Each node is returned once (see TraverseDepthFirstEq), so the graph may have cycles.
+// (See TraverseBreadthFirst function.)
+func TraverseBreadthFirstEq[T any](root T, children func(T) Enumerator[T], equaler Equaler[T]) (Enumerator[T], error) {
+	if children == nil {
+		return nil, ErrNilSelector
+	}
+	return traverseNodes(traverse(root, children, lookupEqFactory(equaler), false, false)), nil
+}
+
+// TraverseBreadthFirstEqMust is like TraverseBreadthFirstEq but panics in case of error.
+func TraverseBreadthFirstEqMust[T any](root T, children func(T) Enumerator[T], equaler Equaler[T]) Enumerator[T] {
+	r, err := TraverseBreadthFirstEq(root, children, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// TraverseBreadthFirstPath is like TraverseBreadthFirst
+// but returns each node with its depth and path from the root.
+func TraverseBreadthFirstPath[T any](root T, children func(T) Enumerator[T]) (Enumerator[TraversalNode[T]], error) {
+	if children == nil {
+		return nil, ErrNilSelector
+	}
+	return traverse(root, children, nil, false, true), nil
+}
+
+// TraverseBreadthFirstPathMust is like TraverseBreadthFirstPath but panics in case of error.
+func TraverseBreadthFirstPathMust[T any](root T, children func(T) Enumerator[T]) Enumerator[TraversalNode[T]] {
+	r, err := TraverseBreadthFirstPath(root, children)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// TraverseBreadthFirstPathEq is like TraverseBreadthFirstEq
+// but returns each node with its depth and path from the root.
+func TraverseBreadthFirstPathEq[T any](root T, children func(T) Enumerator[T], equaler Equaler[T]) (Enumerator[TraversalNode[T]], error) {
+	if children == nil {
+		return nil, ErrNilSelector
+	}
+	return traverse(root, children, lookupEqFactory(equaler), false, true), nil
+}
+
+// TraverseBreadthFirstPathEqMust is like TraverseBreadthFirstPathEq but panics in case of error.
+func TraverseBreadthFirstPathEqMust[T any](root T, children func(T) Enumerator[T], equaler Equaler[T]) Enumerator[TraversalNode[T]] {
+	r, err := TraverseBreadthFirstPathEq(root, children, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
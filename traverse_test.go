@@ -0,0 +1,138 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"fmt"
+	"testing"
+)
+
+// treeChildren returns the children of the nodes of the tree
+//
+//	    1
+//	  / | \
+//	 2  3  4
+//	/ \    |
+//	5  6   7
+func treeChildren(n int) Enumerator[int] {
+	switch n {
+	case 1:
+		return NewOnSliceEn(2, 3, 4)
+	case 2:
+		return NewOnSliceEn(5, 6)
+	case 4:
+		return NewOnSliceEn(7)
+	}
+	return nil
+}
+
+// graphChildren returns the successors of the nodes of the graph with the cycle 1 -> 2 -> 3 -> 1
+func graphChildren(n int) Enumerator[int] {
+	switch n {
+	case 1:
+		return NewOnSliceEn(2, 3)
+	case 2:
+		return NewOnSliceEn(3)
+	case 3:
+		return NewOnSliceEn(1, 4)
+	}
+	return Empty[int]()
+}
+
+func Test_Traverse(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Enumerator[int]
+		want Enumerator[int]
+	}{
+		{name: "DepthFirst", got: TraverseDepthFirstMust(1, treeChildren), want: NewOnSlice(1, 2, 5, 6, 3, 4, 7)},
+		{name: "BreadthFirst", got: TraverseBreadthFirstMust(1, treeChildren), want: NewOnSlice(1, 2, 3, 4, 5, 6, 7)},
+		{name: "DepthFirstLeaf", got: TraverseDepthFirstMust(7, treeChildren), want: NewOnSlice(7)},
+		{name: "DepthFirstEqCycle", got: TraverseDepthFirstEqMust(1, graphChildren, nil), want: NewOnSlice(1, 2, 3, 4)},
+		{name: "BreadthFirstEqCycle",
+			got:  TraverseBreadthFirstEqMust[int](1, graphChildren, Order[int]{}),
+			want: NewOnSlice(1, 2, 3, 4),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !SequenceEqualMust(tt.got, tt.want) {
+				tt.got.Reset()
+				tt.want.Reset()
+				t.Errorf("Traverse%s() = '%v', want '%v'", tt.name, String(tt.got), String(tt.want))
+			}
+			tt.got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(tt.got, tt.want) {
+				t.Errorf("Traverse%s() after Reset differs", tt.name)
+			}
+		})
+	}
+}
+
+func Test_TraversePath(t *testing.T) {
+	format := func(en Enumerator[TraversalNode[int]]) string {
+		return String(SelectMust(en, func(tn TraversalNode[int]) string {
+			return fmt.Sprintf("%d:%v", tn.Depth(), tn.Path())
+		}))
+	}
+	tests := []struct {
+		name string
+		got  Enumerator[TraversalNode[int]]
+		want string
+	}{
+		{name: "DepthFirstPath",
+			got:  TraverseDepthFirstPathMust(1, treeChildren),
+			want: "[0:[1] 1:[1 2] 2:[1 2 5] 2:[1 2 6] 1:[1 3] 1:[1 4] 2:[1 4 7]]",
+		},
+		{name: "BreadthFirstPath",
+			got:  TraverseBreadthFirstPathMust(1, treeChildren),
+			want: "[0:[1] 1:[1 2] 1:[1 3] 1:[1 4] 2:[1 2 5] 2:[1 2 6] 2:[1 4 7]]",
+		},
+		{name: "DepthFirstPathEq",
+			got:  TraverseDepthFirstPathEqMust(1, graphChildren, nil),
+			want: "[0:[1] 1:[1 2] 2:[1 2 3] 3:[1 2 3 4]]",
+		},
+		{name: "BreadthFirstPathEq",
+			got:  TraverseBreadthFirstPathEqMust(1, graphChildren, nil),
+			want: "[0:[1] 1:[1 2] 1:[1 3] 2:[1 3 4]]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := format(tt.got); got != tt.want {
+				t.Errorf("Traverse%s() = '%v', want '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Traverse_lazy(t *testing.T) {
+	// the infinite tree: each node n has the children 2n and 2n+1
+	children := func(n int) Enumerator[int] { return NewOnSliceEn(2*n, 2*n+1) }
+	got := TakeMust(TraverseBreadthFirstMust(1, children), 7)
+	want := NewOnSliceEn(1, 2, 3, 4, 5, 6, 7)
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("TraverseBreadthFirst() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_Traverse_error(t *testing.T) {
+	children := func(n int) Enumerator[int] {
+		if n == 1 {
+			return failAt(NewOnSliceEn(2, 3), 3)
+		}
+		return nil
+	}
+	if _, err := SliceErr(TraverseDepthFirstMust(1, children)); err != errTest {
+		t.Errorf("TraverseDepthFirst() error = '%v', want '%v'", err, errTest)
+	}
+	if _, err := SliceErr(TraverseBreadthFirstMust(1, children)); err != errTest {
+		t.Errorf("TraverseBreadthFirst() error = '%v', want '%v'", err, errTest)
+	}
+	if _, err := TraverseDepthFirst[int](1, nil); err != ErrNilSelector {
+		t.Errorf("TraverseDepthFirst() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+}
@@ -0,0 +1,163 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"sync"
+)
+
+// https://en.wikipedia.org/wiki/Adjacency_list
+
+// TreeNode is a node of a tree built by BuildTree.
+type TreeNode[T any] struct {
+	value    T
+	children []*TreeNode[T]
+}
+
+// Value returns the element of the node.
+func (tn *TreeNode[T]) Value() T {
+	return tn.value
+}
+
+// Children returns the children of the node.
+func (tn *TreeNode[T]) Children() []*TreeNode[T] {
+	return tn.children
+}
+
+// ChildrenEn returns an Enumerator of the children of the node
+// (so TreeNode.ChildrenEn may be passed to TraverseDepthFirst and TraverseBreadthFirst).
+func (tn *TreeNode[T]) ChildrenEn() Enumerator[*TreeNode[T]] {
+	return NewOnSliceEn(tn.children...)
+}
+
+// buildTree builds the trees from 'source', see BuildTree
+func buildTree[Source, Key any](source Enumerator[Source], idSelector, parentIDSelector func(Source) Key,
+	newLookup func() *Lookup[Key, int]) Enumerator[*TreeNode[Source]] {
+	var once sync.Once
+	var roots []*TreeNode[Source]
+	var err error
+	i := -1
+	return OnFunc[*TreeNode[Source]]{
+		mvNxt: func() bool {
+			once.Do(func() {
+				sl := Slice(source)
+				if err = Err(source); err != nil {
+					return
+				}
+				nodes := make([]*TreeNode[Source], len(sl))
+				// ids maps the ids to the positions of the elements
+				ids := newLookup()
+				for j, el := range sl {
+					nodes[j] = &TreeNode[Source]{value: el}
+					ids.add(idSelector(el), j)
+				}
+				for j, el := range sl {
+					pp := ids.ItemSlice(parentIDSelector(el))
+					if len(pp) == 0 {
+						roots = append(roots, nodes[j])
+						continue
+					}
+					p := nodes[pp[0]]
+					p.children = append(p.children, nodes[j])
+				}
+				// the nodes not reachable from the roots are on the cycles
+				reached := 0
+				stack := append([]*TreeNode[Source](nil), roots...)
+				for len(stack) > 0 {
+					nd := stack[len(stack)-1]
+					stack = append(stack[:len(stack)-1], nd.children...)
+					reached++
+				}
+				if reached < len(sl) {
+					roots, err = nil, ErrCycle
+				}
+			})
+			if err != nil || i+1 >= len(roots) {
+				return false
+			}
+			i++
+			return true
+		},
+		crrnt: func() *TreeNode[Source] {
+			if i < 0 || i >= len(roots) {
+				return nil
+			}
+			return roots[i]
+		},
+		rst: func() { i = -1 },
+		err: func() error { return err },
+		cls: func() error { return Close(source) },
+	}
+}
+
+// BuildTree builds the trees from the elements related by their ids and parent ids
+// (e.g. the rows of an "employees" table with the "id" and "manager_id" columns).
+// An element is a child of the element whose id is equal to its parent id
+// (the first such element if the ids are not unique),
+// the elements whose parent ids do not match any id are the roots.
+// reflect.DeepEqual is used to compare the ids.
+// The roots and the children of each node keep the order of the elements in 'source'.
+// 'source' is enumerated on the first call to MoveNext.
+// If the parent relations have a cycle, the enumeration stops
+// and ErrCycle is returned by the Err method of the resulting Enumerator (see ErrEnumerator).
+func BuildTree[Source, Key any](source Enumerator[Source], idSelector, parentIDSelector func(Source) Key) (Enumerator[*TreeNode[Source]], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if idSelector == nil || parentIDSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return buildTree(source, idSelector, parentIDSelector, lookupEqFactory[Key](nil)), nil
+}
+
+// BuildTreeMust is like BuildTree but panics in case of error.
+func BuildTreeMust[Source, Key any](source Enumerator[Source], idSelector, parentIDSelector func(Source) Key) Enumerator[*TreeNode[Source]] {
+	r, err := BuildTree(source, idSelector, parentIDSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// BuildTreeEq builds the trees from the elements related by their ids and parent ids
+// using a specified Equaler to compare the ids. If 'equaler' is nil reflect.DeepEqual is used.
+// (See BuildTree function.)
+func BuildTreeEq[Source, Key any](source Enumerator[Source], idSelector, parentIDSelector func(Source) Key,
+	equaler Equaler[Key]) (Enumerator[*TreeNode[Source]], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if idSelector == nil || parentIDSelector == nil {
+		return nil, ErrNilSelector
+	}
+	return buildTree(source, idSelector, parentIDSelector, lookupEqFactory(equaler)), nil
+}
+
+// BuildTreeEqMust is like BuildTreeEq but panics in case of error.
+func BuildTreeEqMust[Source, Key any](source Enumerator[Source], idSelector, parentIDSelector func(Source) Key,
+	equaler Equaler[Key]) Enumerator[*TreeNode[Source]] {
+	r, err := BuildTreeEq(source, idSelector, parentIDSelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// FlattenTree returns the elements of the trees (see BuildTree) in depth-first order (preorder).
+func FlattenTree[T any](roots Enumerator[*TreeNode[T]]) (Enumerator[T], error) {
+	if roots == nil {
+		return nil, ErrNilSource
+	}
+	return SelectMany(roots, func(root *TreeNode[T]) Enumerator[T] {
+		return SelectMust(TraverseDepthFirstMust(root, (*TreeNode[T]).ChildrenEn), (*TreeNode[T]).Value)
+	})
+}
+
+// FlattenTreeMust is like FlattenTree but panics in case of error.
+func FlattenTreeMust[T any](roots Enumerator[*TreeNode[T]]) Enumerator[T] {
+	r, err := FlattenTree(roots)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
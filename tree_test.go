@@ -0,0 +1,50 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+type treeRow struct {
+	id, parent int
+	name       string
+}
+
+func Test_BuildTree_FlattenTree(t *testing.T) {
+	rows := []treeRow{
+		{id: 3, parent: 1, name: "cto"},
+		{id: 1, parent: 0, name: "ceo"},
+		{id: 4, parent: 3, name: "dev"},
+		{id: 2, parent: 1, name: "cfo"},
+		{id: 5, parent: 0, name: "board"},
+		{id: 6, parent: 3, name: "ops"},
+	}
+	roots := BuildTreeMust(NewOnSliceEn(rows...), func(r treeRow) int { return r.id }, func(r treeRow) int { return r.parent })
+	got := SelectMust(FlattenTreeMust(roots), func(r treeRow) string { return r.name })
+	want := NewOnSliceEn("ceo", "cto", "dev", "ops", "cfo", "board")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("FlattenTree(BuildTree()) = '%v', want '%v'", String(got), String(want))
+	}
+	roots.Reset()
+	if !roots.MoveNext() {
+		t.Fatalf("BuildTree() is empty")
+	}
+	root := roots.Current()
+	if root.Value().name != "ceo" || len(root.Children()) != 2 || root.Children()[1].Value().name != "cfo" {
+		t.Errorf("BuildTree() root = '%v' with children '%v'", root.Value(), root.Children())
+	}
+}
+
+func Test_BuildTree_cycle(t *testing.T) {
+	rows := []treeRow{{id: 1, parent: 0}, {id: 2, parent: 3}, {id: 3, parent: 2}}
+	got := BuildTreeMust(NewOnSliceEn(rows...), func(r treeRow) int { return r.id }, func(r treeRow) int { return r.parent })
+	if _, err := SliceErr(got); err != ErrCycle {
+		t.Errorf("BuildTree() error = '%v', want '%v'", err, ErrCycle)
+	}
+	if _, err := BuildTree[treeRow, int](NewOnSliceEn(rows...), nil, nil); err != ErrNilSelector {
+		t.Errorf("BuildTree() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+}
@@ -32,6 +32,9 @@ func UnionSelf[Source any](first, second Enumerator[Source]) (Enumerator[Source]
 		return nil, ErrNilSource
 	}
 	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
 	first.Reset()
 	return Union(first, NewOnSliceEn(sl2...))
 }
@@ -73,6 +76,9 @@ func UnionEqSelf[Source any](first, second Enumerator[Source], equaler Equaler[S
 		return nil, ErrNilSource
 	}
 	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
 	first.Reset()
 	return UnionEq(first, NewOnSliceEn(sl2...), equaler)
 }
@@ -120,6 +126,9 @@ func UnionCmpSelf[Source any](first, second Enumerator[Source], comparer Compare
 		return nil, ErrNilComparer
 	}
 	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
 	first.Reset()
 	return UnionCmp(first, NewOnSliceEn(sl2...), comparer)
 }
@@ -132,3 +141,53 @@ func UnionCmpSelfMust[Source any](first, second Enumerator[Source], comparer Com
 	}
 	return r
 }
+
+// UnionHash produces the set union of two sequences using a specified Hasher.
+// (See DistinctHash function.)
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionHashSelf instead.
+func UnionHash[Source any](first, second Enumerator[Source], hasher Hasher[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return DistinctHash(ConcatMust(first, second), hasher)
+}
+
+// UnionHashMust is like UnionHash but panics in case of error.
+func UnionHashMust[Source any](first, second Enumerator[Source], hasher Hasher[Source]) Enumerator[Source] {
+	r, err := UnionHash(first, second, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionHashSelf produces the set union of two sequences using a specified Hasher.
+// (See DistinctHash function.)
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method. 'second' is enumerated immediately.
+func UnionHashSelf[Source any](first, second Enumerator[Source], hasher Hasher[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return UnionHash(first, NewOnSliceEn(sl2...), hasher)
+}
+
+// UnionHashSelfMust is like UnionHashSelf but panics in case of error.
+func UnionHashSelfMust[Source any](first, second Enumerator[Source], hasher Hasher[Source]) Enumerator[Source] {
+	r, err := UnionHashSelf(first, second, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -315,3 +315,55 @@ func Test_UnionCmpSelf_int(t *testing.T) {
 		})
 	}
 }
+
+func Test_UnionHash_string(t *testing.T) {
+	type args struct {
+		first  Enumerator[string]
+		second Enumerator[string]
+		hasher Hasher[string]
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        Enumerator[string]
+		wantErr     bool
+		expectedErr error
+	}{
+		{name: "NilHasher",
+			args: args{
+				first:  NewOnSlice("a", "b"),
+				second: NewOnSlice("B", "c"),
+			},
+			wantErr:     true,
+			expectedErr: ErrNilHasher,
+		},
+		{name: "UnionWithCaseInsensitiveHasher",
+			args: args{
+				first:  NewOnSlice("a", "b", "B", "c", "b"),
+				second: NewOnSlice("d", "e", "d", "a"),
+				hasher: CaseInsensitiveHasher,
+			},
+			want: NewOnSlice("a", "b", "c", "d", "e"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnionHash(tt.args.first, tt.args.second, tt.args.hasher)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnionHash() error = '%v', wantErr '%v'", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if err != tt.expectedErr {
+					t.Errorf("UnionHash() error = '%v', expectedErr '%v'", err, tt.expectedErr)
+				}
+				return
+			}
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("UnionHash() = '%v', want '%v'", String(got), String(tt.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,396 @@
+//go:build go1.18
+
+package go2linq
+
+// https://www.postgresql.org/docs/current/queries-union.html
+
+// UnionAll produces the multiset union of two sequences, as SQL UNION ALL does:
+// an element occurs in the result m+n times, where m and n are its numbers of occurrences in the sequences.
+// Since no elements are matched, the result is the concatenation of the sequences (see Concat).
+// The Eq, Cmp, Hash and By variants exist for symmetry with ExceptAll and IntersectAll
+// and only validate their arguments.
+// (Use UnionMax to get max(m, n) occurrences.)
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionAllSelf instead.
+func UnionAll[Source any](first, second Enumerator[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	return Concat(first, second)
+}
+
+// UnionAllMust is like UnionAll but panics in case of error.
+func UnionAllMust[Source any](first, second Enumerator[Source]) Enumerator[Source] {
+	r, err := UnionAll(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllSelf produces the multiset union of two sequences
+// using reflect.DeepEqual to compare values.
+// (See UnionAll function.)
+// 'second' is enumerated immediately.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionAllSelf[Source any](first, second Enumerator[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	return ConcatSelf(first, second)
+}
+
+// UnionAllSelfMust is like UnionAllSelf but panics in case of error.
+func UnionAllSelfMust[Source any](first, second Enumerator[Source]) Enumerator[Source] {
+	r, err := UnionAllSelf(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllEq produces the multiset union of two sequences
+// using the specified Equaler to compare values.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See UnionAll function.)
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionAllEqSelf instead.
+func UnionAllEq[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	return Concat(first, second)
+}
+
+// UnionAllEqMust is like UnionAllEq but panics in case of error.
+func UnionAllEqMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) Enumerator[Source] {
+	r, err := UnionAllEq(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllEqSelf produces the multiset union of two sequences
+// using the specified Equaler to compare values.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See UnionAll function.)
+// 'second' is enumerated immediately.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionAllEqSelf[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	return ConcatSelf(first, second)
+}
+
+// UnionAllEqSelfMust is like UnionAllEqSelf but panics in case of error.
+func UnionAllEqSelfMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) Enumerator[Source] {
+	r, err := UnionAllEqSelf(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllCmp produces the multiset union of two sequences
+// using the specified Comparer to compare values.
+// (See UnionAll function.)
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionAllCmpSelf instead.
+func UnionAllCmp[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return Concat(first, second)
+}
+
+// UnionAllCmpMust is like UnionAllCmp but panics in case of error.
+func UnionAllCmpMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) Enumerator[Source] {
+	r, err := UnionAllCmp(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllCmpSelf produces the multiset union of two sequences
+// using the specified Comparer to compare values.
+// (See UnionAll function.)
+// 'second' is enumerated immediately.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionAllCmpSelf[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return ConcatSelf(first, second)
+}
+
+// UnionAllCmpSelfMust is like UnionAllCmpSelf but panics in case of error.
+func UnionAllCmpSelfMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) Enumerator[Source] {
+	r, err := UnionAllCmpSelf(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllHash produces the multiset union of two sequences
+// using the specified Hasher to compare values.
+// (See UnionAll function.)
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionAllHashSelf instead.
+func UnionAllHash[Source any](first, second Enumerator[Source], hasher Hasher[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return Concat(first, second)
+}
+
+// UnionAllHashMust is like UnionAllHash but panics in case of error.
+func UnionAllHashMust[Source any](first, second Enumerator[Source], hasher Hasher[Source]) Enumerator[Source] {
+	r, err := UnionAllHash(first, second, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllHashSelf produces the multiset union of two sequences
+// using the specified Hasher to compare values.
+// (See UnionAll function.)
+// 'second' is enumerated immediately.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionAllHashSelf[Source any](first, second Enumerator[Source], hasher Hasher[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return ConcatSelf(first, second)
+}
+
+// UnionAllHashSelfMust is like UnionAllHashSelf but panics in case of error.
+func UnionAllHashSelfMust[Source any](first, second Enumerator[Source], hasher Hasher[Source]) Enumerator[Source] {
+	r, err := UnionAllHashSelf(first, second, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllBy produces the multiset union of two sequences according to a specified key selector function
+// using reflect.DeepEqual to compare keys.
+// (See UnionAll function.)
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionAllBySelf instead.
+func UnionAllBy[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	return Concat(first, second)
+}
+
+// UnionAllByMust is like UnionAllBy but panics in case of error.
+func UnionAllByMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) Enumerator[Source] {
+	r, err := UnionAllBy(first, second, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllBySelf produces the multiset union of two sequences according to a specified key selector function
+// using reflect.DeepEqual to compare keys.
+// (See UnionAll function.)
+// 'second' is enumerated immediately.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionAllBySelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	return ConcatSelf(first, second)
+}
+
+// UnionAllBySelfMust is like UnionAllBySelf but panics in case of error.
+func UnionAllBySelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) Enumerator[Source] {
+	r, err := UnionAllBySelf(first, second, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllByEq produces the multiset union of two sequences according to a specified key selector function
+// using the specified Equaler to compare keys.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See UnionAll function.)
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionAllByEqSelf instead.
+func UnionAllByEq[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	return Concat(first, second)
+}
+
+// UnionAllByEqMust is like UnionAllByEq but panics in case of error.
+func UnionAllByEqMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) Enumerator[Source] {
+	r, err := UnionAllByEq(first, second, keySelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllByEqSelf produces the multiset union of two sequences according to a specified key selector function
+// using the specified Equaler to compare keys.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See UnionAll function.)
+// 'second' is enumerated immediately.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionAllByEqSelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	return ConcatSelf(first, second)
+}
+
+// UnionAllByEqSelfMust is like UnionAllByEqSelf but panics in case of error.
+func UnionAllByEqSelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) Enumerator[Source] {
+	r, err := UnionAllByEqSelf(first, second, keySelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllByCmp produces the multiset union of two sequences according to a specified key selector function
+// using the specified Comparer to compare keys.
+// (See UnionAll function.)
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionAllByCmpSelf instead.
+func UnionAllByCmp[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return Concat(first, second)
+}
+
+// UnionAllByCmpMust is like UnionAllByCmp but panics in case of error.
+func UnionAllByCmpMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) Enumerator[Source] {
+	r, err := UnionAllByCmp(first, second, keySelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllByCmpSelf produces the multiset union of two sequences according to a specified key selector function
+// using the specified Comparer to compare keys.
+// (See UnionAll function.)
+// 'second' is enumerated immediately.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionAllByCmpSelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return ConcatSelf(first, second)
+}
+
+// UnionAllByCmpSelfMust is like UnionAllByCmpSelf but panics in case of error.
+func UnionAllByCmpSelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) Enumerator[Source] {
+	r, err := UnionAllByCmpSelf(first, second, keySelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllByHash produces the multiset union of two sequences according to a specified key selector function
+// using the specified Hasher to compare keys.
+// (See UnionAll function.)
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionAllByHashSelf instead.
+func UnionAllByHash[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return Concat(first, second)
+}
+
+// UnionAllByHashMust is like UnionAllByHash but panics in case of error.
+func UnionAllByHashMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) Enumerator[Source] {
+	r, err := UnionAllByHash(first, second, keySelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionAllByHashSelf produces the multiset union of two sequences according to a specified key selector function
+// using the specified Hasher to compare keys.
+// (See UnionAll function.)
+// 'second' is enumerated immediately.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionAllByHashSelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return ConcatSelf(first, second)
+}
+
+// UnionAllByHashSelfMust is like UnionAllByHashSelf but panics in case of error.
+func UnionAllByHashSelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) Enumerator[Source] {
+	r, err := UnionAllByHashSelf(first, second, keySelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,64 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_UnionAllMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  Enumerator[int]
+		second Enumerator[int]
+		want   Enumerator[int]
+	}{
+		{name: "EmptyFirst", first: Empty[int](), second: NewOnSlice(1, 1), want: NewOnSlice(1, 1)},
+		{name: "EmptySecond", first: NewOnSlice(1, 1), second: Empty[int](), want: NewOnSlice(1, 1)},
+		{name: "Multiplicities", first: NewOnSlice(1, 2, 1), second: NewOnSlice(1, 3, 1, 1, 2, 2), want: NewOnSlice(1, 2, 1, 1, 3, 1, 1, 2, 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := UnionAllMust(tt.first, tt.second)
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("UnionAll() = '%v', want '%v'", String(got), String(tt.want))
+			}
+		})
+	}
+}
+
+func Test_UnionAllByEqMust(t *testing.T) {
+	got := UnionAllByEqMust(NewOnSliceEn("a", "b"), NewOnSliceEn("B", "A", "a"), strings.ToLower, CaseInsensitiveEqualer)
+	want := NewOnSliceEn("a", "b", "B", "A", "a")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("UnionAllByEq() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_UnionAllSelfMust(t *testing.T) {
+	e := NewOnSliceEn(1, 2, 2)
+	got := UnionAllSelfMust(e, e)
+	want := NewOnSliceEn(1, 2, 2, 1, 2, 2)
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("UnionAllSelf() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_UnionAll_errors(t *testing.T) {
+	if _, err := UnionAllCmp(NewOnSliceEn(1), NewOnSliceEn(2), nil); err != ErrNilComparer {
+		t.Errorf("UnionAllCmp() error = '%v', want '%v'", err, ErrNilComparer)
+	}
+	if _, err := UnionAllByHash[int, int](NewOnSliceEn(1), NewOnSliceEn(2), nil, nil); err != ErrNilSelector {
+		t.Errorf("UnionAllByHash() error = '%v', want '%v'", err, ErrNilSelector)
+	}
+	if _, err := UnionAllHashSelf(NewOnSliceEn(1), NewOnSliceEn(2), nil); err != ErrNilHasher {
+		t.Errorf("UnionAllHashSelf() error = '%v', want '%v'", err, ErrNilHasher)
+	}
+}
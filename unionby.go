@@ -38,6 +38,9 @@ func UnionBySelf[Source, Key any](first, second Enumerator[Source], keySelector
 		return nil, ErrNilSelector
 	}
 	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
 	first.Reset()
 	return UnionBy(first, NewOnSliceEn(sl2...), keySelector)
 }
@@ -85,6 +88,9 @@ func UnionByEqSelf[Source, Key any](first, second Enumerator[Source], keySelecto
 		return nil, ErrNilSelector
 	}
 	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
 	first.Reset()
 	return UnionByEq(first, NewOnSliceEn(sl2...), keySelector, equaler)
 }
@@ -138,6 +144,9 @@ func UnionByCmpSelf[Source, Key any](first, second Enumerator[Source], keySelect
 		return nil, ErrNilComparer
 	}
 	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
 	first.Reset()
 	return UnionByCmp(first, NewOnSliceEn(sl2...), keySelector, comparer)
 }
@@ -150,3 +159,59 @@ func UnionByCmpSelfMust[Source, Key any](first, second Enumerator[Source], keySe
 	}
 	return r
 }
+
+// UnionByHash produces the set union of two sequences according to a specified key selector function
+// and using a specified Hasher. (See DistinctHash function.)
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionByHashSelf instead.
+func UnionByHash[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return DistinctByHash(ConcatMust(first, second), keySelector, hasher)
+}
+
+// UnionByHashMust is like UnionByHash but panics in case of error.
+func UnionByHashMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) Enumerator[Source] {
+	r, err := UnionByHash(first, second, keySelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionByHashSelf produces the set union of two sequences according to a specified key selector function
+// and using a specified Hasher. (See DistinctHash function.)
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method. 'second' is enumerated immediately.
+func UnionByHashSelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return UnionByHash(first, NewOnSliceEn(sl2...), keySelector, hasher)
+}
+
+// UnionByHashSelfMust is like UnionByHashSelf but panics in case of error.
+func UnionByHashSelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) Enumerator[Source] {
+	r, err := UnionByHashSelf(first, second, keySelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -156,3 +156,14 @@ func Test_UnionByCmpSelf_int_bool(t *testing.T) {
 		})
 	}
 }
+
+func Test_UnionByHashSelf_int_bool(t *testing.T) {
+	e1 := RangeMust(1, 10)
+	got := UnionByHashSelfMust(e1, e1, func(i int) bool { return i%2 == 0 }, BoolHasher)
+	want := NewOnSlice(1, 2)
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("UnionByHashSelf() = '%v', want '%v'", String(got), String(want))
+	}
+}
@@ -0,0 +1,459 @@
+//go:build go1.18
+
+package go2linq
+
+// https://docs.python.org/3/library/collections.html#collections.Counter
+
+// UnionMax produces the multiset union of two sequences
+// using reflect.DeepEqual to compare values.
+// An element occurs in the result max(m, n) times, where m and n are its numbers of occurrences in the sequences:
+// all the elements of 'first' are returned followed by the elements of 'second' that are not matched by the elements of 'first'.
+// (Use UnionAll to get the sum of occurrences, as SQL UNION ALL does.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionMaxSelf instead.
+func UnionMax[Source any](first, second Enumerator[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	return bagOp(first, second, Identity[Source], lookupEqFactory[Source](nil), bagUnion), nil
+}
+
+// UnionMaxMust is like UnionMax but panics in case of error.
+func UnionMaxMust[Source any](first, second Enumerator[Source]) Enumerator[Source] {
+	r, err := UnionMax(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxSelf produces the multiset union of two sequences
+// using reflect.DeepEqual to compare values.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionMaxSelf[Source any](first, second Enumerator[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return UnionMax(first, NewOnSliceEn(sl2...))
+}
+
+// UnionMaxSelfMust is like UnionMaxSelf but panics in case of error.
+func UnionMaxSelfMust[Source any](first, second Enumerator[Source]) Enumerator[Source] {
+	r, err := UnionMaxSelf(first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxEq produces the multiset union of two sequences
+// using the specified Equaler to compare values.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionMaxEqSelf instead.
+func UnionMaxEq[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	return bagOp(first, second, Identity[Source], lookupEqFactory(equaler), bagUnion), nil
+}
+
+// UnionMaxEqMust is like UnionMaxEq but panics in case of error.
+func UnionMaxEqMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) Enumerator[Source] {
+	r, err := UnionMaxEq(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxEqSelf produces the multiset union of two sequences
+// using the specified Equaler to compare values.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionMaxEqSelf[Source any](first, second Enumerator[Source], equaler Equaler[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return UnionMaxEq(first, NewOnSliceEn(sl2...), equaler)
+}
+
+// UnionMaxEqSelfMust is like UnionMaxEqSelf but panics in case of error.
+func UnionMaxEqSelfMust[Source any](first, second Enumerator[Source], equaler Equaler[Source]) Enumerator[Source] {
+	r, err := UnionMaxEqSelf(first, second, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxCmp produces the multiset union of two sequences
+// using the specified Comparer to compare values.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionMaxCmpSelf instead.
+func UnionMaxCmp[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return bagOp(first, second, Identity[Source], lookupCmpFactory(comparer), bagUnion), nil
+}
+
+// UnionMaxCmpMust is like UnionMaxCmp but panics in case of error.
+func UnionMaxCmpMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) Enumerator[Source] {
+	r, err := UnionMaxCmp(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxCmpSelf produces the multiset union of two sequences
+// using the specified Comparer to compare values.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionMaxCmpSelf[Source any](first, second Enumerator[Source], comparer Comparer[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return UnionMaxCmp(first, NewOnSliceEn(sl2...), comparer)
+}
+
+// UnionMaxCmpSelfMust is like UnionMaxCmpSelf but panics in case of error.
+func UnionMaxCmpSelfMust[Source any](first, second Enumerator[Source], comparer Comparer[Source]) Enumerator[Source] {
+	r, err := UnionMaxCmpSelf(first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxHash produces the multiset union of two sequences
+// using the specified Hasher to compare values.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionMaxHashSelf instead.
+func UnionMaxHash[Source any](first, second Enumerator[Source], hasher Hasher[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return bagOp(first, second, Identity[Source], lookupHashFactory(hasher), bagUnion), nil
+}
+
+// UnionMaxHashMust is like UnionMaxHash but panics in case of error.
+func UnionMaxHashMust[Source any](first, second Enumerator[Source], hasher Hasher[Source]) Enumerator[Source] {
+	r, err := UnionMaxHash(first, second, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxHashSelf produces the multiset union of two sequences
+// using the specified Hasher to compare values.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionMaxHashSelf[Source any](first, second Enumerator[Source], hasher Hasher[Source]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return UnionMaxHash(first, NewOnSliceEn(sl2...), hasher)
+}
+
+// UnionMaxHashSelfMust is like UnionMaxHashSelf but panics in case of error.
+func UnionMaxHashSelfMust[Source any](first, second Enumerator[Source], hasher Hasher[Source]) Enumerator[Source] {
+	r, err := UnionMaxHashSelf(first, second, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxBy produces the multiset union of two sequences according to a specified key selector function
+// using reflect.DeepEqual to compare keys.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionMaxBySelf instead.
+func UnionMaxBy[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	return bagOp(first, second, keySelector, lookupEqFactory[Key](nil), bagUnion), nil
+}
+
+// UnionMaxByMust is like UnionMaxBy but panics in case of error.
+func UnionMaxByMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) Enumerator[Source] {
+	r, err := UnionMaxBy(first, second, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxBySelf produces the multiset union of two sequences according to a specified key selector function
+// using reflect.DeepEqual to compare keys.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionMaxBySelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return UnionMaxBy(first, NewOnSliceEn(sl2...), keySelector)
+}
+
+// UnionMaxBySelfMust is like UnionMaxBySelf but panics in case of error.
+func UnionMaxBySelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key) Enumerator[Source] {
+	r, err := UnionMaxBySelf(first, second, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxByEq produces the multiset union of two sequences according to a specified key selector function
+// using the specified Equaler to compare keys.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionMaxByEqSelf instead.
+func UnionMaxByEq[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	return bagOp(first, second, keySelector, lookupEqFactory(equaler), bagUnion), nil
+}
+
+// UnionMaxByEqMust is like UnionMaxByEq but panics in case of error.
+func UnionMaxByEqMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) Enumerator[Source] {
+	r, err := UnionMaxByEq(first, second, keySelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxByEqSelf produces the multiset union of two sequences according to a specified key selector function
+// using the specified Equaler to compare keys.
+// If 'equaler' is nil reflect.DeepEqual is used.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionMaxByEqSelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return UnionMaxByEq(first, NewOnSliceEn(sl2...), keySelector, equaler)
+}
+
+// UnionMaxByEqSelfMust is like UnionMaxByEqSelf but panics in case of error.
+func UnionMaxByEqSelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, equaler Equaler[Key]) Enumerator[Source] {
+	r, err := UnionMaxByEqSelf(first, second, keySelector, equaler)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxByCmp produces the multiset union of two sequences according to a specified key selector function
+// using the specified Comparer to compare keys.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionMaxByCmpSelf instead.
+func UnionMaxByCmp[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	return bagOp(first, second, keySelector, lookupCmpFactory(comparer), bagUnion), nil
+}
+
+// UnionMaxByCmpMust is like UnionMaxByCmp but panics in case of error.
+func UnionMaxByCmpMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) Enumerator[Source] {
+	r, err := UnionMaxByCmp(first, second, keySelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxByCmpSelf produces the multiset union of two sequences according to a specified key selector function
+// using the specified Comparer to compare keys.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionMaxByCmpSelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if comparer == nil {
+		return nil, ErrNilComparer
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return UnionMaxByCmp(first, NewOnSliceEn(sl2...), keySelector, comparer)
+}
+
+// UnionMaxByCmpSelfMust is like UnionMaxByCmpSelf but panics in case of error.
+func UnionMaxByCmpSelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, comparer Comparer[Key]) Enumerator[Source] {
+	r, err := UnionMaxByCmpSelf(first, second, keySelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxByHash produces the multiset union of two sequences according to a specified key selector function
+// using the specified Hasher to compare keys.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' must not be based on the same Enumerator, otherwise use UnionMaxByHashSelf instead.
+func UnionMaxByHash[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	return bagOp(first, second, keySelector, lookupHashFactory(hasher), bagUnion), nil
+}
+
+// UnionMaxByHashMust is like UnionMaxByHash but panics in case of error.
+func UnionMaxByHashMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) Enumerator[Source] {
+	r, err := UnionMaxByHash(first, second, keySelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// UnionMaxByHashSelf produces the multiset union of two sequences according to a specified key selector function
+// using the specified Hasher to compare keys.
+// (See UnionMax function.)
+// 'second' is enumerated immediately.
+// Order of elements in the result corresponds to the order of elements in 'first' and then in 'second'.
+// 'first' and 'second' may be based on the same Enumerator.
+// 'first' must have real Reset method.
+func UnionMaxByHashSelf[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) (Enumerator[Source], error) {
+	if first == nil || second == nil {
+		return nil, ErrNilSource
+	}
+	if keySelector == nil {
+		return nil, ErrNilSelector
+	}
+	if hasher == nil {
+		return nil, ErrNilHasher
+	}
+	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
+	first.Reset()
+	return UnionMaxByHash(first, NewOnSliceEn(sl2...), keySelector, hasher)
+}
+
+// UnionMaxByHashSelfMust is like UnionMaxByHashSelf but panics in case of error.
+func UnionMaxByHashSelfMust[Source, Key any](first, second Enumerator[Source], keySelector func(Source) Key, hasher Hasher[Key]) Enumerator[Source] {
+	r, err := UnionMaxByHashSelf(first, second, keySelector, hasher)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
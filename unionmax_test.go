@@ -0,0 +1,56 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+)
+
+func Test_UnionMaxMust(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  Enumerator[int]
+		second Enumerator[int]
+		want   Enumerator[int]
+	}{
+		{name: "EmptyFirst", first: Empty[int](), second: NewOnSlice(1, 1), want: NewOnSlice(1, 1)},
+		{name: "EmptySecond", first: NewOnSlice(1, 1), second: Empty[int](), want: NewOnSlice(1, 1)},
+		{name: "Multiplicities", first: NewOnSlice(1, 2, 1), second: NewOnSlice(1, 3, 1, 1, 2, 2), want: NewOnSlice(1, 2, 1, 3, 1, 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := UnionMaxMust(tt.first, tt.second)
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("UnionMax() = '%v', want '%v'", String(got), String(tt.want))
+			}
+			got.Reset()
+			tt.want.Reset()
+			if !SequenceEqualMust(got, tt.want) {
+				t.Errorf("UnionMax() after Reset differs")
+			}
+		})
+	}
+}
+
+func Test_UnionMaxEqMust(t *testing.T) {
+	got := UnionMaxEqMust(NewOnSliceEn("a", "b"), NewOnSliceEn("B", "A", "a", "c"), CaseInsensitiveEqualer)
+	want := NewOnSliceEn("a", "b", "a", "c")
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("UnionMaxEq() = '%v', want '%v'", String(got), String(want))
+	}
+}
+
+func Test_UnionMaxSelfMust(t *testing.T) {
+	e := NewOnSliceEn(1, 2, 2)
+	got := UnionMaxSelfMust(e, e)
+	want := NewOnSliceEn(1, 2, 2)
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("UnionMaxSelf() = '%v', want '%v'", String(got), String(want))
+	}
+}
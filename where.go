@@ -27,6 +27,8 @@ func Where[Source any](source Enumerator[Source], predicate func(Source) bool) (
 			},
 			crrnt: func() Source { return c },
 			rst:   func() { source.Reset() },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
 		},
 		nil
 }
@@ -64,6 +66,8 @@ func WhereIdx[Source any](source Enumerator[Source], predicate func(Source, int)
 			},
 			crrnt: func() Source { return c },
 			rst:   func() { i = -1; source.Reset() },
+			err:   func() error { return Err(source) },
+			cls:   func() error { return Close(source) },
 		},
 		nil
 }
@@ -76,3 +80,50 @@ func WhereIdxMust[Source any](source Enumerator[Source], predicate func(Source,
 	}
 	return r
 }
+
+// WhereErr filters a sequence of values based on a fallible predicate.
+// The first error returned by 'predicate' stops the enumeration
+// and is reported by the Err method of the resulting ErrEnumerator.
+func WhereErr[Source any](source Enumerator[Source], predicate func(Source) (bool, error)) (ErrEnumerator[Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if predicate == nil {
+		return nil, ErrNilPredicate
+	}
+	var c Source
+	var perr error
+	return OnFunc[Source]{
+			mvNxt: func() bool {
+				if perr != nil {
+					return false
+				}
+				for source.MoveNext() {
+					c = source.Current()
+					var ok bool
+					ok, perr = predicate(c)
+					if perr != nil {
+						return false
+					}
+					if ok {
+						return true
+					}
+				}
+				return false
+			},
+			crrnt: func() Source { return c },
+			rst:   func() { perr = nil; source.Reset() },
+			err:   func() error { return firstErr(perr, Err(source)) },
+			cls:   func() error { return Close(source) },
+		},
+		nil
+}
+
+// WhereErrMust is like WhereErr but panics in case of error.
+func WhereErrMust[Source any](source Enumerator[Source], predicate func(Source) (bool, error)) ErrEnumerator[Source] {
+	r, err := WhereErr(source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
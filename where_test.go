@@ -221,3 +221,23 @@ func Test_WhereIdx_string(t *testing.T) {
 		})
 	}
 }
+
+func Test_WhereErr_int(t *testing.T) {
+	got := WhereErrMust(NewOnSliceEn(1, 2, 3, 4, 5), func(i int) (bool, error) {
+		if i == 4 {
+			return false, errTest
+		}
+		return i%2 == 1, nil
+	})
+	sl, err := SliceErr[int](got)
+	if err != errTest {
+		t.Errorf("WhereErr() error = '%v', want '%v'", err, errTest)
+	}
+	if sl != nil {
+		t.Errorf("WhereErr() = '%v', want nil", sl)
+	}
+	got.Reset()
+	if !got.MoveNext() || got.Current() != 1 || got.Err() != nil {
+		t.Errorf("WhereErr().Reset() does not clear error")
+	}
+}
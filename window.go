@@ -0,0 +1,186 @@
+//go:build go1.18
+
+package go2linq
+
+// https://morelinq.github.io/3.3/ref/api/html/M_MoreLinq_MoreEnumerable_Window__1.htm
+// https://morelinq.github.io/3.3/ref/api/html/Overload_MoreLinq_MoreEnumerable_Segment.htm
+
+// window returns the sliding windows of 'source', see Window
+func window[Source any](source Enumerator[Source], size, step int, copyWindow bool) Enumerator[[]Source] {
+	// buf[start:start+size] is the current window,
+	// the buffer is compacted when the window reaches its end, so each element is moved O(1) times on average
+	buf := make([]Source, 0, 2*size)
+	start := -1
+	return OnFunc[[]Source]{
+		mvNxt: func() bool {
+			if start < 0 {
+				start = 0
+			} else {
+				start += step
+			}
+			// skip the elements between the windows (if 'step' is greater than 'size')
+			for start > len(buf) {
+				if !source.MoveNext() {
+					return false
+				}
+				start--
+			}
+			if start+size > cap(buf) {
+				n := copy(buf, buf[start:])
+				buf, start = buf[:n], 0
+			}
+			for len(buf) < start+size {
+				if !source.MoveNext() {
+					return false
+				}
+				buf = append(buf, source.Current())
+			}
+			return true
+		},
+		crrnt: func() []Source {
+			if start < 0 || len(buf) < start+size {
+				return nil
+			}
+			w := buf[start : start+size : start+size]
+			if copyWindow {
+				return append([]Source(nil), w...)
+			}
+			return w
+		},
+		rst: func() {
+			buf, start = buf[:0], -1
+			source.Reset()
+		},
+		err: func() error { return Err(source) },
+		cls: func() error { return Close(source) },
+	}
+}
+
+// Window returns the sliding windows of the sequence.
+// Each window contains 'size' consecutive elements, each next window starts 'step' elements after the previous one
+// (so Window(source, size, size) returns the non-overlapping windows, as Chunk does,
+// and Window(source, size, 1) returns all the windows of 'size' consecutive elements).
+// The incomplete windows at the end of the sequence are not returned.
+//
+// The windows share the internal buffer, so a window is valid until the next call to MoveNext
+// (the elements are not copied for each window). Use WindowCopy to get the independent windows.
+func Window[Source any](source Enumerator[Source], size, step int) (Enumerator[[]Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if size <= 0 || step <= 0 {
+		return nil, ErrSizeOutOfRange
+	}
+	return window(source, size, step, false), nil
+}
+
+// WindowMust is like Window but panics in case of error.
+func WindowMust[Source any](source Enumerator[Source], size, step int) Enumerator[[]Source] {
+	r, err := Window(source, size, step)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// WindowCopy is like Window but each window is a separate slice that remains valid after the next call to MoveNext.
+func WindowCopy[Source any](source Enumerator[Source], size, step int) (Enumerator[[]Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if size <= 0 || step <= 0 {
+		return nil, ErrSizeOutOfRange
+	}
+	return window(source, size, step, true), nil
+}
+
+// WindowCopyMust is like WindowCopy but panics in case of error.
+func WindowCopyMust[Source any](source Enumerator[Source], size, step int) Enumerator[[]Source] {
+	r, err := WindowCopy(source, size, step)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// splitBefore splits 'source' into the windows, a new window starts before the element 'cur'
+// if split(prev, cur) returns true ('prev' is the previous element)
+func splitBefore[Source any](source Enumerator[Source], split func(prev, cur Source) bool) Enumerator[[]Source] {
+	var w, c []Source
+	var next Source
+	var hasNext bool
+	return OnFunc[[]Source]{
+		mvNxt: func() bool {
+			w = nil
+			if hasNext {
+				w = append(w, next)
+				hasNext = false
+			}
+			for source.MoveNext() {
+				el := source.Current()
+				if len(w) > 0 && split(w[len(w)-1], el) {
+					next, hasNext = el, true
+					break
+				}
+				w = append(w, el)
+			}
+			if len(w) == 0 || Err(source) != nil {
+				c = nil
+				return false
+			}
+			c = w
+			return true
+		},
+		crrnt: func() []Source { return c },
+		rst: func() {
+			w, c, hasNext = nil, nil, false
+			source.Reset()
+		},
+		err: func() error { return Err(source) },
+		cls: func() error { return Close(source) },
+	}
+}
+
+// WindowBy splits the sequence into the session windows.
+// A new window starts when 'gapPredicate' returns true for two adjacent elements,
+// e.g. when the time gap between two adjacent events exceeds the session timeout
+// or the key of the element differs from the key of the previous one.
+func WindowBy[Source any](source Enumerator[Source], gapPredicate func(prev, cur Source) bool) (Enumerator[[]Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if gapPredicate == nil {
+		return nil, ErrNilPredicate
+	}
+	return splitBefore(source, gapPredicate), nil
+}
+
+// WindowByMust is like WindowBy but panics in case of error.
+func WindowByMust[Source any](source Enumerator[Source], gapPredicate func(prev, cur Source) bool) Enumerator[[]Source] {
+	r, err := WindowBy(source, gapPredicate)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Segment splits the sequence into the segments, a new segment starts with each element satisfying 'predicate'
+// (except the first element of the sequence, which always starts the first segment).
+func Segment[Source any](source Enumerator[Source], predicate func(Source) bool) (Enumerator[[]Source], error) {
+	if source == nil {
+		return nil, ErrNilSource
+	}
+	if predicate == nil {
+		return nil, ErrNilPredicate
+	}
+	return splitBefore(source, func(_, cur Source) bool { return predicate(cur) }), nil
+}
+
+// SegmentMust is like Segment but panics in case of error.
+func SegmentMust[Source any](source Enumerator[Source], predicate func(Source) bool) Enumerator[[]Source] {
+	r, err := Segment(source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,182 @@
+//go:build go1.18
+
+package go2linq
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_WindowCopyMust(t *testing.T) {
+	type args struct {
+		source Enumerator[int]
+		size   int
+		step   int
+	}
+	tests := []struct {
+		name string
+		args args
+		want Enumerator[[]int]
+	}{
+		{name: "EmptySource",
+			args: args{source: Empty[int](), size: 2, step: 1},
+			want: NewOnSlice([][]int{}...),
+		},
+		{name: "ShortSource",
+			args: args{source: NewOnSlice(1, 2), size: 3, step: 1},
+			want: NewOnSlice([][]int{}...),
+		},
+		{name: "Sliding",
+			args: args{source: NewOnSlice(1, 2, 3, 4, 5), size: 3, step: 1},
+			want: NewOnSlice([]int{1, 2, 3}, []int{2, 3, 4}, []int{3, 4, 5}),
+		},
+		{name: "Step2",
+			args: args{source: NewOnSlice(1, 2, 3, 4, 5, 6), size: 3, step: 2},
+			want: NewOnSlice([]int{1, 2, 3}, []int{3, 4, 5}),
+		},
+		{name: "Tumbling",
+			args: args{source: NewOnSlice(1, 2, 3, 4, 5), size: 2, step: 2},
+			want: NewOnSlice([]int{1, 2}, []int{3, 4}),
+		},
+		{name: "Hopping",
+			args: args{source: NewOnSlice(1, 2, 3, 4, 5, 6, 7, 8), size: 2, step: 3},
+			want: NewOnSlice([]int{1, 2}, []int{4, 5}, []int{7, 8}),
+		},
+		{name: "Size1",
+			args: args{source: NewOnSlice(1, 2, 3), size: 1, step: 1},
+			want: NewOnSlice([]int{1}, []int{2}, []int{3}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WindowCopyMust(tt.args.source, tt.args.size, tt.args.step)
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("WindowCopy() = '%v', want '%v'", String(got), String(tt.want))
+			}
+		})
+	}
+}
+
+func Test_Window_SharedBuffer(t *testing.T) {
+	got := WindowMust(RangeMust(1, 100), 4, 1)
+	var sums []int
+	for got.MoveNext() {
+		w := got.Current()
+		if len(w) != 4 || cap(w) != 4 {
+			t.Fatalf("Window() len = %v, cap = %v, want 4, 4", len(w), cap(w))
+		}
+		sums = append(sums, SumMust[int, int](NewOnSlice(w...), Identity[int]))
+	}
+	if len(sums) != 97 || sums[0] != 10 || sums[96] != 97+98+99+100 {
+		t.Errorf("Window() sums = %v", sums)
+	}
+	// the windows of WindowCopy remain valid
+	all := Slice(WindowCopyMust(RangeMust(1, 10), 3, 1))
+	if len(all) != 8 || all[0][0] != 1 || all[7][2] != 10 {
+		t.Errorf("WindowCopy() = %v", all)
+	}
+}
+
+func Test_Window_Reset(t *testing.T) {
+	got := WindowCopyMust(NewOnSlice(1, 2, 3, 4), 2, 1)
+	want := NewOnSlice([]int{1, 2}, []int{2, 3}, []int{3, 4})
+	for i := 0; i < 2; i++ {
+		if !SequenceEqualMust(got, want) {
+			got.Reset()
+			want.Reset()
+			t.Errorf("WindowCopy() = '%v', want '%v'", String(got), String(want))
+		}
+		got.Reset()
+		want.Reset()
+	}
+}
+
+func Test_Window_errors(t *testing.T) {
+	if _, err := Window(NewOnSliceEn(1), 0, 1); err != ErrSizeOutOfRange {
+		t.Errorf("Window() error = '%v', want '%v'", err, ErrSizeOutOfRange)
+	}
+	if _, err := WindowCopy(NewOnSliceEn(1), 1, 0); err != ErrSizeOutOfRange {
+		t.Errorf("WindowCopy() error = '%v', want '%v'", err, ErrSizeOutOfRange)
+	}
+	if _, err := Window[int](nil, 1, 1); err != ErrNilSource {
+		t.Errorf("Window() error = '%v', want '%v'", err, ErrNilSource)
+	}
+	if _, err := SliceErr(WindowMust[int](failAt(NewOnSliceEn(1, 2, 3), 3), 2, 1)); err != errTest {
+		t.Errorf("Window() error = '%v', want '%v'", err, errTest)
+	}
+}
+
+func Test_WindowByMust(t *testing.T) {
+	type event struct {
+		user string
+		at   time.Duration
+	}
+	events := NewOnSlice(
+		event{"a", 0}, event{"a", 5 * time.Minute}, event{"a", 50 * time.Minute},
+		event{"a", 60 * time.Minute}, event{"b", 61 * time.Minute},
+	)
+	// a new session starts after 30 minutes of inactivity or when the user changes
+	got := SelectMust(
+		WindowByMust(events, func(prev, cur event) bool {
+			return cur.user != prev.user || cur.at-prev.at > 30*time.Minute
+		}),
+		func(ee []event) int { return len(ee) },
+	)
+	want := NewOnSlice(2, 2, 1)
+	if !SequenceEqualMust(got, want) {
+		got.Reset()
+		want.Reset()
+		t.Errorf("WindowBy() = '%v', want '%v'", String(got), String(want))
+	}
+	got2 := WindowByMust(NewOnSlice(1, 2, 3, 7, 8, 20), func(prev, cur int) bool { return cur-prev > 1 })
+	want2 := NewOnSlice([]int{1, 2, 3}, []int{7, 8}, []int{20})
+	if !SequenceEqualMust(got2, want2) {
+		got2.Reset()
+		want2.Reset()
+		t.Errorf("WindowBy() = '%v', want '%v'", String(got2), String(want2))
+	}
+	if _, err := WindowBy[int](NewOnSliceEn(1), nil); err != ErrNilPredicate {
+		t.Errorf("WindowBy() error = '%v', want '%v'", err, ErrNilPredicate)
+	}
+}
+
+func Test_SegmentMust(t *testing.T) {
+	type args struct {
+		source    Enumerator[string]
+		predicate func(string) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want Enumerator[[]string]
+	}{
+		{name: "EmptySource",
+			args: args{source: Empty[string](), predicate: func(s string) bool { return s == "#" }},
+			want: NewOnSlice([][]string{}...),
+		},
+		{name: "Headers",
+			args: args{source: NewOnSlice("#", "a", "b", "#", "c", "#"), predicate: func(s string) bool { return s == "#" }},
+			want: NewOnSlice([]string{"#", "a", "b"}, []string{"#", "c"}, []string{"#"}),
+		},
+		{name: "FirstNotHeader",
+			args: args{source: NewOnSlice("a", "#", "b"), predicate: func(s string) bool { return s == "#" }},
+			want: NewOnSlice([]string{"a"}, []string{"#", "b"}),
+		},
+		{name: "NoSplit",
+			args: args{source: NewOnSlice("a", "b"), predicate: func(s string) bool { return false }},
+			want: NewOnSlice([]string{"a", "b"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SegmentMust(tt.args.source, tt.args.predicate)
+			if !SequenceEqualMust(got, tt.want) {
+				got.Reset()
+				tt.want.Reset()
+				t.Errorf("Segment() = '%v', want '%v'", String(got), String(tt.want))
+			}
+		})
+	}
+}
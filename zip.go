@@ -21,10 +21,15 @@ func Zip[First, Second, Result any](first Enumerator[First], second Enumerator[S
 				if first.MoveNext() && second.MoveNext() {
 					return true
 				}
+				// one of the sequences is over, the rest of the other one is not needed
+				Close(first)
+				Close(second)
 				return false
 			},
 			crrnt: func() Result { return resultSelector(first.Current(), second.Current()) },
 			rst:   func() { first.Reset(); second.Reset() },
+			err:   func() error { return firstErr(Err(first), Err(second)) },
+			cls:   func() error { return firstErr(Close(first), Close(second)) },
 		},
 		nil
 }
@@ -52,6 +57,9 @@ func ZipSelf[First, Second, Result any](first Enumerator[First], second Enumerat
 		return nil, ErrNilSelector
 	}
 	sl2 := Slice(second)
+	if err := Err(second); err != nil {
+		return nil, err
+	}
 	first.Reset()
 	return Zip(first, NewOnSliceEn(sl2...), resultSelector)
 }